@@ -9,30 +9,62 @@ import (
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 	"log"
+	"os"
 	"time"
 )
 
 const (
-	flagTLS = "tls"
+	flagTLS           = "tls"
+	flagInsecureToken = "insecure-token"
+	// Bearer token sent to provers enforcing an authorization policy
+	envToken = "GALOISD_TOKEN"
 )
 
+type bearerToken struct {
+	token string
+	// The prover may sit behind a proxy terminating TLS, the token is only
+	// sent in cleartext if explicitly allowed.
+	allowInsecure bool
+}
+
+func (t bearerToken) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + t.token}, nil
+}
+
+func (t bearerToken) RequireTransportSecurity() bool {
+	return !t.allowInsecure
+}
+
+func dial(cmd *cobra.Command, uri string) *grpc.ClientConn {
+	tlsEnabled, err := cmd.Flags().GetString(flagTLS)
+	if err != nil {
+		log.Fatal(err)
+	}
+	var creds credentials.TransportCredentials
+	if tlsEnabled == "yes" || tlsEnabled == "true" || tlsEnabled == "1" {
+		creds = credentials.NewTLS(&tls.Config{})
+	} else {
+		creds = insecure.NewCredentials()
+	}
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(creds)}
+	if token := os.Getenv(envToken); token != "" {
+		// Commands without the flag never send the token in cleartext
+		insecureToken, _ := cmd.Flags().GetBool(flagInsecureToken)
+		if creds.Info().SecurityProtocol != "tls" && !insecureToken {
+			log.Fatalf("%s is only sent over TLS, use --%s or, behind a proxy terminating TLS, --%s", envToken, flagTLS, flagInsecureToken)
+		}
+		opts = append(opts, grpc.WithPerRPCCredentials(bearerToken{token: token, allowInsecure: insecureToken}))
+	}
+	conn, err := grpc.Dial(uri, opts...)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return conn
+}
+
 func MakeCobra(f func(context.Context, provergrpc.UnionProverAPIClient, *cobra.Command, []string) error) func(*cobra.Command, []string) error {
 	return func(cmd *cobra.Command, args []string) error {
-		tlsEnabled, err := cmd.Flags().GetString(flagTLS)
-		if err != nil {
-			log.Fatal(err)
-		}
-		var creds credentials.TransportCredentials
-		if tlsEnabled == "yes" || tlsEnabled == "true" || tlsEnabled == "1" {
-			creds = credentials.NewTLS(&tls.Config{})
-		} else {
-			creds = insecure.NewCredentials()
-		}
-		uri := args[0]
-		conn, err := grpc.Dial(uri, grpc.WithTransportCredentials(creds))
-		if err != nil {
-			log.Fatal(err)
-		}
+		conn := dial(cmd, args[0])
 		defer conn.Close()
 		client := provergrpc.NewUnionProverAPIClient(conn)
 		ctx, cancel := context.WithTimeout(context.Background(), 1*time.Hour)
@@ -40,3 +72,14 @@ func MakeCobra(f func(context.Context, provergrpc.UnionProverAPIClient, *cobra.C
 		return f(ctx, client, cmd, args)
 	}
 }
+
+func MakeAdminCobra(f func(context.Context, provergrpc.UnionProverAdminAPIClient, *cobra.Command, []string) error) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		conn := dial(cmd, args[0])
+		defer conn.Close()
+		client := provergrpc.NewUnionProverAdminAPIClient(conn)
+		ctx, cancel := context.WithTimeout(context.Background(), 1*time.Hour)
+		defer cancel()
+		return f(ctx, client, cmd, args)
+	}
+}
@@ -0,0 +1,193 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	provergrpcapi "galois/grpc/api/v3"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	flagAddr          = "addr"
+	flagDrainDuration = "for"
+)
+
+// Delay between two load polls while waiting for the jobs to drain.
+const drainPollInterval = 5 * time.Second
+
+func CtlCmd() *cobra.Command {
+	var cmd = &cobra.Command{
+		Short: "Operate a running prover daemon through its admin service",
+		Use:   "ctl",
+	}
+	cmd.PersistentFlags().String(flagAddr, "", "Address of the prover daemon.")
+	cmd.PersistentFlags().String(flagTLS, "", "Whether the gRPC endpoint expect TLS.")
+	cmd.PersistentFlags().Bool(flagInsecureToken, false, "Send the GALOISD_TOKEN bearer token without TLS, e.g. to a proxy terminating TLS on the same host.")
+	cmd.MarkPersistentFlagRequired(flagAddr)
+	cmd.AddCommand(CtlStatusCmd(), CtlDrainCmd(), CtlResumeCmd(), CtlReloadKeysCmd(), CtlSetLogLevelCmd(), CtlConnectionsCmd())
+	return cmd
+}
+
+func makeCtl(f func(context.Context, *grpc.ClientConn, *cobra.Command, []string) error) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		addr, err := cmd.Flags().GetString(flagAddr)
+		if err != nil {
+			return err
+		}
+		conn := dial(cmd, addr)
+		defer conn.Close()
+		ctx, cancel := context.WithTimeout(context.Background(), 1*time.Hour)
+		defer cancel()
+		return f(ctx, conn, cmd, args)
+	}
+}
+
+func printJSON(v any) error {
+	bz, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(bz))
+	return nil
+}
+
+func CtlStatusCmd() *cobra.Command {
+	return &cobra.Command{
+		Short: "Show the build, circuits, load and serving state of the daemon",
+		Use:   "status",
+		Args:  cobra.NoArgs,
+		RunE: makeCtl(func(ctx context.Context, conn *grpc.ClientConn, cmd *cobra.Command, args []string) error {
+			client := provergrpcapi.NewUnionProverAPIClient(conn)
+			info, err := client.GetInfo(ctx, &provergrpcapi.GetInfoRequest{})
+			if err != nil {
+				return err
+			}
+			load, err := client.GetLoad(ctx, &provergrpcapi.GetLoadRequest{})
+			if err != nil {
+				return err
+			}
+			return printJSON(struct {
+				Info *provergrpcapi.GetInfoResponse `json:"info"`
+				Load *provergrpcapi.GetLoadResponse `json:"load"`
+			}{info, load})
+		}),
+	}
+}
+
+func CtlDrainCmd() *cobra.Command {
+	var cmd = &cobra.Command{
+		Short: "Stop accepting new jobs right away, the running and queued ones completing",
+		Use:   "drain",
+		Args:  cobra.NoArgs,
+		RunE: makeCtl(func(ctx context.Context, conn *grpc.ClientConn, cmd *cobra.Command, args []string) error {
+			duration, err := cmd.Flags().GetDuration(flagDrainDuration)
+			if err != nil {
+				return err
+			}
+			wait, err := cmd.Flags().GetBool(flagWait)
+			if err != nil {
+				return err
+			}
+			now := time.Now()
+			res, err := provergrpcapi.NewUnionProverAdminAPIClient(conn).ScheduleMaintenance(ctx, &provergrpcapi.ScheduleMaintenanceRequest{
+				Window: &provergrpcapi.MaintenanceWindow{
+					DrainAt: timestamppb.New(now),
+					Start:   timestamppb.New(now),
+					End:     timestamppb.New(now.Add(duration)),
+				},
+			})
+			if err != nil {
+				return err
+			}
+			if err := printJSON(res.Window); err != nil {
+				return err
+			}
+			if !wait {
+				return nil
+			}
+			client := provergrpcapi.NewUnionProverAPIClient(conn)
+			for {
+				load, err := client.GetLoad(ctx, &provergrpcapi.GetLoadRequest{})
+				if err != nil {
+					return err
+				}
+				if load.RunningJobs == 0 && load.QueuedJobs == 0 {
+					return nil
+				}
+				fmt.Fprintf(os.Stderr, "Waiting for %d running and %d queued jobs\n", load.RunningJobs, load.QueuedJobs)
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				case <-time.After(drainPollInterval):
+				}
+			}
+		}),
+	}
+	cmd.Flags().Duration(flagDrainDuration, time.Hour, "How long the daemon rejects new jobs, unless resumed before.")
+	cmd.Flags().Bool(flagWait, false, "Wait for the running and queued jobs to complete.")
+	return cmd
+}
+
+func CtlResumeCmd() *cobra.Command {
+	return &cobra.Command{
+		Short: "Accept new jobs again after a drain or maintenance",
+		Use:   "resume",
+		Args:  cobra.NoArgs,
+		RunE: makeCtl(func(ctx context.Context, conn *grpc.ClientConn, cmd *cobra.Command, args []string) error {
+			_, err := provergrpcapi.NewUnionProverAdminAPIClient(conn).CancelMaintenance(ctx, &provergrpcapi.CancelMaintenanceRequest{})
+			return err
+		}),
+	}
+}
+
+func CtlReloadKeysCmd() *cobra.Command {
+	return &cobra.Command{
+		Short: "Load the circuit and keys of the daemon again from its storage, they must be for the same circuit",
+		Use:   "reload-keys",
+		Args:  cobra.NoArgs,
+		RunE: makeCtl(func(ctx context.Context, conn *grpc.ClientConn, cmd *cobra.Command, args []string) error {
+			res, err := provergrpcapi.NewUnionProverAdminAPIClient(conn).ReloadKeys(ctx, &provergrpcapi.ReloadKeysRequest{})
+			if err != nil {
+				return err
+			}
+			return printJSON(res)
+		}),
+	}
+}
+
+func CtlSetLogLevelCmd() *cobra.Command {
+	return &cobra.Command{
+		Short: "Change the log level of the daemon until it restarts, e.g. debug",
+		Use:   "set-log-level [level]",
+		Args:  cobra.ExactArgs(1),
+		RunE: makeCtl(func(ctx context.Context, conn *grpc.ClientConn, cmd *cobra.Command, args []string) error {
+			res, err := provergrpcapi.NewUnionProverAdminAPIClient(conn).SetLogLevel(ctx, &provergrpcapi.SetLogLevelRequest{Level: args[0]})
+			if err != nil {
+				return err
+			}
+			return printJSON(res)
+		}),
+	}
+}
+
+func CtlConnectionsCmd() *cobra.Command {
+	return &cobra.Command{
+		Short: "List the open connections of the daemon with their bytes, requests and time spent receiving them",
+		Use:   "connections",
+		Args:  cobra.NoArgs,
+		RunE: makeCtl(func(ctx context.Context, conn *grpc.ClientConn, cmd *cobra.Command, args []string) error {
+			res, err := provergrpcapi.NewUnionProverAdminAPIClient(conn).ListConnections(ctx, &provergrpcapi.ListConnectionsRequest{})
+			if err != nil {
+				return err
+			}
+			return printJSON(res)
+		}),
+	}
+}
@@ -0,0 +1,178 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	provergrpc "galois/grpc/api/v3"
+	"galois/pkg/lightclient"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagReference = "reference"
+	flagFixtures  = "fixtures"
+)
+
+// Outcome of a fixture on one implementation.
+type difftestRun struct {
+	res     *provergrpc.ProveResponse
+	err     error
+	elapsed time.Duration
+}
+
+// Each implementation is given its own timeout, such that a slow one doesn't
+// eat into the budget of the other.
+func difftestProve(timeout time.Duration, client provergrpc.UnionProverAPIClient, req *provergrpc.ProveRequest) difftestRun {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	start := time.Now()
+	res, err := client.Prove(ctx, req)
+	return difftestRun{res: res, err: err, elapsed: time.Since(start)}
+}
+
+func difftestVerify(timeout time.Duration, client provergrpc.UnionProverAPIClient, proof *provergrpc.ZeroKnowledgeProof, inputsHash []byte) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	res, err := client.Verify(ctx, &provergrpc.VerifyRequest{
+		Proof:      proof,
+		InputsHash: inputsHash,
+	})
+	if err != nil {
+		return false, err
+	}
+	return res.Valid, nil
+}
+
+// Compare the outcomes of a fixture proven by both implementations, returning
+// the divergences. Each proof is verified by both of them, the proofs themselves
+// being randomized and never identical.
+func difftestCompare(timeout time.Duration, target, reference provergrpc.UnionProverAPIClient, f *fixture, got, want difftestRun) []string {
+	if (got.err == nil) != (want.err == nil) {
+		return []string{fmt.Sprintf("prove: target error %v, reference error %v", got.err, want.err)}
+	}
+	var divergences []string
+	if !bytes.Equal(got.res.TrustedValidatorSetRoot, want.res.TrustedValidatorSetRoot) {
+		divergences = append(divergences, fmt.Sprintf("trusted validator set root: target %X, reference %X", got.res.TrustedValidatorSetRoot, want.res.TrustedValidatorSetRoot))
+	}
+	if !bytes.Equal(got.res.Proof.GetPublicInputs(), want.res.Proof.GetPublicInputs()) {
+		divergences = append(divergences, fmt.Sprintf("public inputs: target %X, reference %X", got.res.Proof.GetPublicInputs(), want.res.Proof.GetPublicInputs()))
+	}
+	if !bytes.Equal(got.res.InputsCommitment.GetDigest(), want.res.InputsCommitment.GetDigest()) {
+		divergences = append(divergences, fmt.Sprintf("inputs commitment: target %X, reference %X", got.res.InputsCommitment.GetDigest(), want.res.InputsCommitment.GetDigest()))
+	}
+	inputsHash := f.inputsHash()
+	for _, proof := range []struct {
+		name string
+		run  difftestRun
+	}{{"target", got}, {"reference", want}} {
+		for _, verifier := range []struct {
+			name   string
+			client provergrpc.UnionProverAPIClient
+		}{{"target", target}, {"reference", reference}} {
+			valid, err := difftestVerify(timeout, verifier.client, proof.run.res.Proof, inputsHash)
+			if err != nil {
+				divergences = append(divergences, fmt.Sprintf("verify %s proof on %s: %v", proof.name, verifier.name, err))
+			} else if !valid {
+				divergences = append(divergences, fmt.Sprintf("verify %s proof on %s: rejected", proof.name, verifier.name))
+			}
+		}
+	}
+	return divergences
+}
+
+func DifftestCmd() *cobra.Command {
+	var cmd = &cobra.Command{
+		Short: "Run the same fixture requests through two prover implementations and report where their public inputs, proof validity or timings diverge",
+		Use:   "difftest",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			target, err := cmd.Flags().GetString(flagTarget)
+			if err != nil {
+				return err
+			}
+			reference, err := cmd.Flags().GetString(flagReference)
+			if err != nil {
+				return err
+			}
+			nbOfFixtures, err := cmd.Flags().GetInt(flagFixtures)
+			if err != nil {
+				return err
+			}
+			nbOfValidators, err := cmd.Flags().GetInt(flagNbOfValidators)
+			if err != nil {
+				return err
+			}
+			requestTimeout, err := cmd.Flags().GetDuration(flagRequestTimeout)
+			if err != nil {
+				return err
+			}
+			if target == "" || reference == "" {
+				return fmt.Errorf("--%s and --%s are required", flagTarget, flagReference)
+			}
+			if nbOfValidators < 1 || nbOfValidators > lightclient.MaxVal {
+				return fmt.Errorf("the number of validators must be between 1 and %d", lightclient.MaxVal)
+			}
+
+			targetConn := dial(cmd, target)
+			defer targetConn.Close()
+			referenceConn := dial(cmd, reference)
+			defer referenceConn.Close()
+			targetClient := provergrpc.NewUnionProverAPIClient(targetConn)
+			referenceClient := provergrpc.NewUnionProverAPIClient(referenceConn)
+
+			var targetTimes, referenceTimes []time.Duration
+			diverged, failed := 0, 0
+			for i := 0; i < nbOfFixtures; i++ {
+				f, err := newFixture(nbOfValidators)
+				if err != nil {
+					return err
+				}
+				got := difftestProve(requestTimeout, targetClient, f.request)
+				want := difftestProve(requestTimeout, referenceClient, f.request)
+				if got.err == nil {
+					targetTimes = append(targetTimes, got.elapsed)
+				}
+				if want.err == nil {
+					referenceTimes = append(referenceTimes, want.elapsed)
+				}
+				timings := fmt.Sprintf("target=%s reference=%s", got.elapsed.Round(time.Millisecond), want.elapsed.Round(time.Millisecond))
+				// The fixtures are valid, both implementations failing them
+				// agree on nothing and tell nothing of the comparison.
+				if got.err != nil && want.err != nil {
+					failed++
+					fmt.Printf("FAIL fixture %d (%s)\n", i, timings)
+					fmt.Printf("  target error: %v\n", got.err)
+					fmt.Printf("  reference error: %v\n", want.err)
+					continue
+				}
+				divergences := difftestCompare(requestTimeout, targetClient, referenceClient, f, got, want)
+				if len(divergences) == 0 {
+					fmt.Printf("MATCH fixture %d (%s)\n", i, timings)
+					continue
+				}
+				diverged++
+				fmt.Printf("DIVERGE fixture %d (%s)\n", i, timings)
+				for _, divergence := range divergences {
+					fmt.Printf("  %s\n", divergence)
+				}
+			}
+			fmt.Printf("target prove latency: %s\n", latencySummary(targetTimes))
+			fmt.Printf("reference prove latency: %s\n", latencySummary(referenceTimes))
+			if diverged > 0 || failed > 0 {
+				return fmt.Errorf("%d of %d fixture(s) diverged, %d failed on both implementations", diverged, nbOfFixtures, failed)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().String(flagTarget, "", "URI of the prover under test.")
+	cmd.Flags().String(flagReference, "", "URI of the reference implementation, serving the same API and keys.")
+	cmd.Flags().String(flagTLS, "", "Whether the gRPC endpoints expect TLS.")
+	cmd.Flags().Bool(flagInsecureToken, false, "Send the GALOISD_TOKEN bearer token without TLS, e.g. to a proxy terminating TLS on the same host.")
+	cmd.Flags().Int(flagFixtures, 4, "Number of fixture requests submitted to both implementations.")
+	cmd.Flags().Int(flagNbOfValidators, 4, "Number of validators of the generated requests.")
+	cmd.Flags().Duration(flagRequestTimeout, 30*time.Minute, "Time after which a prove or verify call is abandoned, given to each implementation separately.")
+	return cmd
+}
@@ -0,0 +1,287 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	provergrpc "galois/grpc"
+	provergrpcapi "galois/grpc/api/v3"
+	"galois/pkg/apierror"
+	"galois/pkg/lightclient"
+	"galois/pkg/listener"
+	"net"
+	"time"
+
+	"github.com/consensys/gnark/logger"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	flagNbOfValidators = "validators"
+)
+
+type e2eCase struct {
+	name string
+	run  func(context.Context, provergrpcapi.UnionProverAPIClient) error
+}
+
+// Returned by the cases depending on a case that failed.
+var errSkipped = errors.New("skipped")
+
+// Check the call failed with an API error of the given kind.
+func expectAPIError(err error, kind *apierror.Error) error {
+	if err == nil {
+		return fmt.Errorf("expected %s error, the call succeeded", kind.Reason)
+	}
+	if !errors.Is(apierror.FromError(err), kind) {
+		return fmt.Errorf("expected %s error, got %w", kind.Reason, err)
+	}
+	return nil
+}
+
+func e2eCases(f *fixture) []e2eCase {
+	var proof *provergrpcapi.ZeroKnowledgeProof
+	requireProof := func() error {
+		if proof == nil {
+			return fmt.Errorf("%w, no proof was generated", errSkipped)
+		}
+		return nil
+	}
+	return []e2eCase{
+		{
+			name: "prove",
+			run: func(ctx context.Context, client provergrpcapi.UnionProverAPIClient) error {
+				res, err := client.Prove(ctx, f.request)
+				if err != nil {
+					return err
+				}
+				if !bytes.Equal(res.TrustedValidatorSetRoot, f.validatorsHash) {
+					return fmt.Errorf("trusted validator set root mismatch, expected %X got %X", f.validatorsHash, res.TrustedValidatorSetRoot)
+				}
+				proof = res.Proof
+				return nil
+			},
+		},
+		{
+			name: "poll-done",
+			run: func(ctx context.Context, client provergrpcapi.UnionProverAPIClient) error {
+				if err := requireProof(); err != nil {
+					return err
+				}
+				res, err := client.Poll(ctx, &provergrpcapi.PollRequest{Request: f.request})
+				if err != nil {
+					return err
+				}
+				done := res.GetDone()
+				if done == nil {
+					return fmt.Errorf("expected the proof to be done, got %v", res.Result)
+				}
+				if !proto.Equal(done.Response.Proof, proof) {
+					return fmt.Errorf("polled proof differs from the proved one")
+				}
+				return nil
+			},
+		},
+		{
+			name: "verify",
+			run: func(ctx context.Context, client provergrpcapi.UnionProverAPIClient) error {
+				if err := requireProof(); err != nil {
+					return err
+				}
+				res, err := client.Verify(ctx, &provergrpcapi.VerifyRequest{
+					Proof:      proof,
+					InputsHash: f.inputsHash(),
+				})
+				if err != nil {
+					return err
+				}
+				if !res.Valid {
+					return fmt.Errorf("valid proof rejected")
+				}
+				return nil
+			},
+		},
+		{
+			name: "verify-tampered-inputs",
+			run: func(ctx context.Context, client provergrpcapi.UnionProverAPIClient) error {
+				if err := requireProof(); err != nil {
+					return err
+				}
+				inputsHash := f.inputsHash()
+				inputsHash[len(inputsHash)-1] ^= 1
+				res, err := client.Verify(ctx, &provergrpcapi.VerifyRequest{
+					Proof:      proof,
+					InputsHash: inputsHash,
+				})
+				if err != nil {
+					return err
+				}
+				if res.Valid {
+					return fmt.Errorf("proof accepted for tampered inputs")
+				}
+				return nil
+			},
+		},
+		{
+			name: "verify-tampered-proof",
+			run: func(ctx context.Context, client provergrpcapi.UnionProverAPIClient) error {
+				if err := requireProof(); err != nil {
+					return err
+				}
+				tampered := proto.Clone(proof).(*provergrpcapi.ZeroKnowledgeProof)
+				tampered.CompressedContent[len(tampered.CompressedContent)/2] ^= 1
+				res, err := client.Verify(ctx, &provergrpcapi.VerifyRequest{
+					Proof:      tampered,
+					InputsHash: f.inputsHash(),
+				})
+				// An undecodable proof is as good as an invalid one
+				if err != nil {
+					return expectAPIError(err, apierror.ErrInvalidRequest)
+				}
+				if res.Valid {
+					return fmt.Errorf("tampered proof accepted")
+				}
+				return nil
+			},
+		},
+		{
+			name: "prove-too-many-validators",
+			run: func(ctx context.Context, client provergrpcapi.UnionProverAPIClient) error {
+				req := proto.Clone(f.request).(*provergrpcapi.ProveRequest)
+				for len(req.UntrustedCommit.Validators) <= lightclient.MaxVal {
+					req.UntrustedCommit.Validators = append(req.UntrustedCommit.Validators, req.UntrustedCommit.Validators[0])
+				}
+				_, err := client.Prove(ctx, req)
+				return expectAPIError(err, apierror.ErrTooLarge)
+			},
+		},
+		{
+			name: "prove-invalid-signature",
+			run: func(ctx context.Context, client provergrpcapi.UnionProverAPIClient) error {
+				req := proto.Clone(f.request).(*provergrpcapi.ProveRequest)
+				sig, err := f.privKeys[0].Sign([]byte("not the vote"))
+				if err != nil {
+					return err
+				}
+				req.UntrustedCommit.Signatures[0] = sig
+				_, err = client.Prove(ctx, req)
+				return expectAPIError(err, apierror.ErrUnsatisfied)
+			},
+		},
+	}
+}
+
+func TestE2ECmd() *cobra.Command {
+	var cmd = &cobra.Command{
+		Short: "Run the canonical prove/verify flows against an in-process prover, exits with a non-zero code on mismatch",
+		Use:   "test-e2e",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := checkExpectedCircuitProfile(cmd); err != nil {
+				return err
+			}
+			r1csPath, err := cmd.Flags().GetString(flagR1CS)
+			if err != nil {
+				return err
+			}
+			pkPath, err := cmd.Flags().GetString(flagPK)
+			if err != nil {
+				return err
+			}
+			vkPath, err := cmd.Flags().GetString(flagVK)
+			if err != nil {
+				return err
+			}
+			nbOfValidators, err := cmd.Flags().GetInt(flagNbOfValidators)
+			if err != nil {
+				return err
+			}
+			if nbOfValidators < 1 || nbOfValidators > lightclient.MaxVal {
+				return fmt.Errorf("the number of validators must be between 1 and %d", lightclient.MaxVal)
+			}
+			logLevel, err := cmd.Flags().GetInt(flagLogLevel)
+			if err != nil {
+				return err
+			}
+			zerolog.SetGlobalLevel(zerolog.Level(logLevel))
+			logger.Disable()
+
+			lis, err := net.Listen("tcp", "127.0.0.1:0")
+			if err != nil {
+				return err
+			}
+			serveCtx, stop := context.WithCancel(context.Background())
+			defer stop()
+			ready := make(chan struct{})
+			served := make(chan error, 1)
+			go func() {
+				served <- provergrpc.Serve(serveCtx, provergrpc.Config{
+					Listeners: []net.Listener{lis},
+					Accept: listener.Config{
+						MaxConn: 1,
+						Timeout: time.Minute,
+					},
+					R1CSPath: r1csPath,
+					PKPath:   pkPath,
+					VKPath:   vkPath,
+					OnStart: []func(*grpc.Server) error{
+						func(*grpc.Server) error {
+							close(ready)
+							return nil
+						},
+					},
+				})
+			}()
+			select {
+			case <-ready:
+			case err := <-served:
+				return err
+			}
+
+			conn, err := grpc.Dial(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+			client := provergrpcapi.NewUnionProverAPIClient(conn)
+
+			f, err := newFixture(nbOfValidators)
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 1*time.Hour)
+			defer cancel()
+
+			failures := 0
+			for _, c := range e2eCases(f) {
+				start := time.Now()
+				err := c.run(ctx, client)
+				if errors.Is(err, errSkipped) {
+					fmt.Printf("SKIP %s: %v\n", c.name, err)
+				} else if err != nil {
+					failures++
+					fmt.Printf("FAIL %s (%s): %v\n", c.name, time.Since(start).Round(time.Millisecond), err)
+				} else {
+					fmt.Printf("PASS %s (%s)\n", c.name, time.Since(start).Round(time.Millisecond))
+				}
+			}
+			if failures > 0 {
+				return fmt.Errorf("%d case(s) failed", failures)
+			}
+			return nil
+		},
+	}
+	addExpectedCircuitProfileFlag(cmd)
+	cmd.Flags().String(flagR1CS, "r1cs.bin", "Path to the compiled R1CS circuit, generated along with dev keys if missing.")
+	cmd.Flags().String(flagPK, "pk.bin", "Path to the proving key.")
+	cmd.Flags().String(flagVK, "vk.bin", "Path to the verifying key.")
+	cmd.Flags().Int(flagNbOfValidators, 4, "Number of validators of the generated requests.")
+	cmd.Flags().Int(flagLogLevel, int(zerolog.WarnLevel), "Log level of the in-process prover.")
+	return cmd
+}
@@ -240,5 +240,6 @@ func ExampleVerifyCmd() *cobra.Command {
 		}),
 	}
 	cmd.Flags().String(flagTLS, "", "Whether the gRPC endpoint expect TLS.")
+	cmd.Flags().Bool(flagInsecureToken, false, "Send the GALOISD_TOKEN bearer token without TLS, e.g. to a proxy terminating TLS on the same host.")
 	return cmd
 }
@@ -0,0 +1,207 @@
+package cmd
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"math/big"
+	"time"
+
+	tmtypes "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	version "github.com/cometbft/cometbft/api/cometbft/version/v1"
+	cometbn254 "github.com/cometbft/cometbft/crypto/bn254"
+	ce "github.com/cometbft/cometbft/crypto/encoding"
+	"github.com/cometbft/cometbft/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	provergrpc "galois/grpc/api/v3"
+)
+
+// A randomly generated, valid proof request along with the data required to check the result.
+type fixture struct {
+	request        *provergrpc.ProveRequest
+	header         *types.Header
+	validatorsHash []byte
+	privKeys       []cometbn254.PrivKey
+	signedBytes    []byte
+}
+
+// Generate a valid request where both the trusted and untrusted validator sets are the same.
+func newFixture(nbOfValidators int) (*fixture, error) {
+	return newFixtureAt(nbOfValidators, time.Now().UTC())
+}
+
+// Generate a valid request for a header timestamped at the given time, the
+// rest being drawn from crypto/rand.Reader.
+func newFixtureAt(nbOfValidators int, timestamp time.Time) (*fixture, error) {
+	toValidator := func(pubKey []byte) (*tmtypes.SimpleValidator, error) {
+		protoPK, err := ce.PubKeyToProto(cometbn254.PubKey(pubKey))
+		if err != nil {
+			return &tmtypes.SimpleValidator{}, err
+		}
+		power, err := rand.Int(rand.Reader, big.NewInt(9223372036854775807/8))
+		if err != nil {
+			return &tmtypes.SimpleValidator{}, err
+		}
+		return &tmtypes.SimpleValidator{
+			PubKey:      &protoPK,
+			VotingPower: sdk.TokensToConsensusPower(math.NewInt(power.Int64()), sdk.DefaultPowerReduction),
+		}, nil
+	}
+
+	privKeys := make([]cometbn254.PrivKey, nbOfValidators)
+	validators := make([]*tmtypes.SimpleValidator, nbOfValidators)
+	totalPower := int64(0)
+	for i := 0; i < len(validators); i++ {
+		privKeys[i] = cometbn254.GenPrivKey()
+		val, err := toValidator(privKeys[i].PubKey().Bytes())
+		if err != nil {
+			return nil, err
+		}
+		totalPower += val.VotingPower
+		validators[i] = val
+	}
+
+	validatorsHash, err := marshalValidators(validators)
+	if err != nil {
+		return nil, err
+	}
+
+	randomHash := func() []byte {
+		value := make([]byte, 32)
+		_, err = rand.Read(value)
+		if err != nil {
+			panic(err)
+		}
+		return value
+	}
+
+	randomMiMCHash := func() []byte {
+		value := randomHash()
+		value[0] = 0
+		return value
+	}
+
+	chainID := "union-devnet-1337"
+
+	header := &types.Header{
+		Version: version.Consensus{
+			Block: 11,
+			App:   0,
+		},
+		ChainID: chainID,
+		Height:  0xCAFEBABE,
+		Time:    timestamp,
+		LastBlockID: types.BlockID{
+			Hash: randomMiMCHash(),
+			PartSetHeader: types.PartSetHeader{
+				Total: 1,
+				Hash:  randomHash(),
+			},
+		},
+		LastCommitHash:     randomHash(),
+		DataHash:           randomHash(),
+		ValidatorsHash:     validatorsHash,
+		NextValidatorsHash: validatorsHash,
+		ConsensusHash:      randomHash(),
+		AppHash:            randomHash(),
+		LastResultsHash:    randomHash(),
+		EvidenceHash:       randomHash(),
+		ProposerAddress:    randomHash(),
+	}
+
+	vote := &tmtypes.Vote{
+		Type:   tmtypes.PrecommitType,
+		Height: 0xCAFEBABE,
+		Round:  0xC0DE,
+		BlockID: tmtypes.BlockID{
+			Hash: header.Hash(),
+			PartSetHeader: tmtypes.PartSetHeader{
+				Total: 1,
+				Hash:  randomMiMCHash(),
+			},
+		},
+	}
+
+	signedBytes := types.VoteSignBytes(chainID, vote)
+
+	var signatures [][]byte
+	var bitmap big.Int
+	votingPower := 0
+
+	for votingPower < int(totalPower)/3*2 {
+		index, err := rand.Int(rand.Reader, big.NewInt(int64(nbOfValidators)))
+		if err != nil {
+			return nil, err
+		}
+		i := index.Int64()
+		if bitmap.Bit(int(i)) == 0 {
+			votingPower += int(validators[i].VotingPower)
+			bitmap.SetBit(&bitmap, int(i), 1)
+			sig, err := privKeys[i].Sign(signedBytes)
+			if err != nil {
+				return nil, err
+			}
+			signatures = append(signatures, sig)
+		}
+	}
+
+	canonicalVote := types.CanonicalizeVote(chainID, vote)
+
+	return &fixture{
+		request: &provergrpc.ProveRequest{
+			Vote:            &canonicalVote,
+			UntrustedHeader: header.ToProto(),
+			TrustedCommit: &provergrpc.ValidatorSetCommit{
+				Validators: validators,
+				Signatures: signatures,
+				Bitmap:     bitmap.Bytes(),
+			},
+			UntrustedCommit: &provergrpc.ValidatorSetCommit{
+				Validators: validators,
+				Signatures: signatures,
+				Bitmap:     bitmap.Bytes(),
+			},
+		},
+		header:         header,
+		validatorsHash: validatorsHash,
+		privKeys:       privKeys,
+		signedBytes:    signedBytes,
+	}, nil
+}
+
+// The public input of the circuit, computed the same way a light client would.
+// It is deliberately not derived from the prover code, such that the vectors and
+// the e2e and difftest expectations catch an encoding bug of the prover.
+func lightClientInputsHash(chainID string, height int64, timestamp time.Time, validatorsHash, nextValidatorsHash, appHash, trustedValidatorsHash []byte) []byte {
+	buff := []byte{}
+	var padded [32]byte
+	writeI64 := func(x int64) {
+		big.NewInt(x).FillBytes(padded[:])
+		buff = append(buff, padded[:]...)
+	}
+	writeMiMCHash := func(b []byte) {
+		big.NewInt(0).SetBytes(b).FillBytes(padded[:])
+		buff = append(buff, padded[:]...)
+	}
+	writeHash := func(b []byte) {
+		buff = append(buff, b...)
+	}
+	writeMiMCHash([]byte(chainID))
+	writeI64(height)
+	writeI64(timestamp.Unix())
+	writeI64(int64(timestamp.Nanosecond()))
+	writeMiMCHash(validatorsHash)
+	writeMiMCHash(nextValidatorsHash)
+	writeHash(appHash)
+	writeMiMCHash(trustedValidatorsHash)
+	hash := sha256.Sum256(buff)
+	return hash[1:]
+}
+
+func (f *fixture) inputsHash() []byte {
+	h := f.header
+	return lightClientInputsHash(h.ChainID, h.Height, h.Time, h.ValidatorsHash, h.NextValidatorsHash, h.AppHash, f.validatorsHash)
+}
@@ -44,5 +44,6 @@ func GenContract() *cobra.Command {
 	}
 	cmd.Flags().String(flagPath, "", "Path were to write the file. If empty, dump to stdout.")
 	cmd.Flags().String(flagTLS, "", "Whether the gRPC endpoint expect TLS.")
+	cmd.Flags().Bool(flagInsecureToken, false, "Send the GALOISD_TOKEN bearer token without TLS, e.g. to a proxy terminating TLS on the same host.")
 	return cmd
 }
@@ -0,0 +1,64 @@
+package cmd
+
+import (
+	"fmt"
+	provergrpc "galois/grpc"
+	"galois/pkg/lightclient"
+	"galois/pkg/storage"
+
+	"github.com/spf13/cobra"
+)
+
+const flagExpectCircuitProfile = "expect-circuit-profile"
+
+// The circuit size is fixed at build time, the flag doesn't select a profile
+// but asserts the one the binary was built for, guarding against running a
+// binary built for another profile.
+func checkExpectedCircuitProfile(cmd *cobra.Command) error {
+	profile, err := cmd.Flags().GetString(flagExpectCircuitProfile)
+	if err != nil {
+		return err
+	}
+	if profile == "" || profile == lightclient.Profile {
+		return nil
+	}
+	if profile == "test" {
+		return fmt.Errorf("This binary is built for the %s circuit profile, the test circuit requires building with -tags test_circuit", lightclient.Profile)
+	}
+	return fmt.Errorf("This binary is built for the %s circuit profile, not %s", lightclient.Profile, profile)
+}
+
+func addExpectedCircuitProfileFlag(cmd *cobra.Command) {
+	cmd.Flags().String(flagExpectCircuitProfile, "", fmt.Sprintf("Fail unless the binary was built for this circuit profile, production or test. It doesn't select the profile, which is fixed at build time, the test circuit requiring -tags test_circuit. This binary is built for %s.", lightclient.Profile))
+}
+
+func GenDevKeysCmd() *cobra.Command {
+	var cmd = &cobra.Command{
+		Short: "Compile the circuit and generate its keys with an insecure, single party setup, for development and integration tests",
+		Use:   "gen-dev-keys",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := checkExpectedCircuitProfile(cmd); err != nil {
+				return err
+			}
+			r1csPath, err := cmd.Flags().GetString(flagR1CS)
+			if err != nil {
+				return err
+			}
+			pkPath, err := cmd.Flags().GetString(flagPK)
+			if err != nil {
+				return err
+			}
+			vkPath, err := cmd.Flags().GetString(flagVK)
+			if err != nil {
+				return err
+			}
+			return provergrpc.GenerateKeys(storage.NewFilesystem(""), r1csPath, pkPath, vkPath)
+		},
+	}
+	addExpectedCircuitProfileFlag(cmd)
+	cmd.Flags().String(flagR1CS, "r1cs.bin", "Path the compiled R1CS circuit is written to.")
+	cmd.Flags().String(flagPK, "pk.bin", "Path the proving key is written to.")
+	cmd.Flags().String(flagVK, "vk.bin", "Path the verifying key is written to.")
+	return cmd
+}
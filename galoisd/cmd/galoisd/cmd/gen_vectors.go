@@ -0,0 +1,293 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	provergrpc "galois/grpc"
+	provergrpcapi "galois/grpc/api/v3"
+	"galois/pkg/lightclient"
+	"galois/pkg/verify"
+	mathrand "math/rand/v2"
+	"os"
+	"path/filepath"
+	"time"
+
+	tmtypes "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	"github.com/consensys/gnark/logger"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagOut  = "out"
+	flagSeed = "seed"
+)
+
+// Timestamp of the header of the first fixture, the next ones being a minute
+// apart.
+var vectorsEpoch = time.Unix(1700000000, 0).UTC()
+
+// Bytes encoded as 0x prefixed hex, as the Solidity and CosmWasm test suites
+// take them.
+type hexBytes []byte
+
+func (b hexBytes) MarshalJSON() ([]byte, error) {
+	return json.Marshal("0x" + hex.EncodeToString(b))
+}
+
+// A proof along with the light client inputs it is verified against, the
+// verifier deriving the inputs hash from them.
+type testVector struct {
+	Name                  string   `json:"name"`
+	Description           string   `json:"description"`
+	ChainID               string   `json:"chain_id"`
+	Height                int64    `json:"height"`
+	TimeSeconds           int64    `json:"time_seconds"`
+	TimeNanos             int32    `json:"time_nanos"`
+	ValidatorsHash        hexBytes `json:"validators_hash"`
+	NextValidatorsHash    hexBytes `json:"next_validators_hash"`
+	AppHash               hexBytes `json:"app_hash"`
+	TrustedValidatorsHash hexBytes `json:"trusted_validators_hash"`
+	InputsHash            hexBytes `json:"inputs_hash"`
+	// The evm_proof of the prover: A, B, C, the commitment and its proof of
+	// knowledge, uncompressed
+	Proof hexBytes `json:"proof"`
+	Valid bool     `json:"valid"`
+}
+
+type vectorsManifest struct {
+	Seed      string   `json:"seed"`
+	CircuitID hexBytes `json:"circuit_id"`
+	Vectors   []string `json:"vectors"`
+}
+
+type vectorCase struct {
+	name        string
+	description string
+	// Tamper with the light client inputs or the proof, nil for the valid case
+	header func(*tmtypes.Header)
+	root   func([]byte)
+	proof  func([]byte)
+}
+
+var vectorCases = []vectorCase{
+	{
+		name:        "valid",
+		description: "Proof of the header, as generated by the prover",
+	},
+	{
+		name:        "tampered-proof-a",
+		description: "A point of the proof altered",
+		proof:       func(p []byte) { p[40] ^= 1 },
+	},
+	{
+		name:        "tampered-proof-b",
+		description: "B point of the proof altered",
+		proof:       func(p []byte) { p[120] ^= 1 },
+	},
+	{
+		name:        "tampered-proof-c",
+		description: "C point of the proof altered",
+		proof:       func(p []byte) { p[220] ^= 1 },
+	},
+	{
+		name:        "tampered-commitment",
+		description: "Commitment of the proof altered",
+		proof:       func(p []byte) { p[300] ^= 1 },
+	},
+	{
+		name:        "tampered-commitment-pok",
+		description: "Proof of knowledge of the commitment altered",
+		proof:       func(p []byte) { p[len(p)-1] ^= 1 },
+	},
+	{
+		name:        "swapped-proof-points",
+		description: "A and C points of the proof swapped",
+		proof: func(p []byte) {
+			var a [64]byte
+			copy(a[:], p[:64])
+			copy(p[:64], p[192:256])
+			copy(p[192:256], a[:])
+		},
+	},
+	{
+		name:        "tampered-app-hash",
+		description: "App hash of the header altered, the proof being for the original one",
+		header:      func(h *tmtypes.Header) { h.AppHash[0] ^= 1 },
+	},
+	{
+		name:        "tampered-height",
+		description: "Height of the header altered, the proof being for the original one",
+		header:      func(h *tmtypes.Header) { h.Height++ },
+	},
+	{
+		name:        "tampered-trusted-validators-hash",
+		description: "Trusted validator set root altered, the proof being for the original one",
+		root:        func(r []byte) { r[len(r)-1] ^= 1 },
+	},
+}
+
+// Build the vector of a case from a valid proof of the fixture. The expected
+// result is checked against the off-chain verifier, such that a tampering
+// leaving the proof valid is caught here rather than in the on-chain suites.
+func newTestVector(vk *verify.VerifyingKey, fixtureName string, f *fixture, res *provergrpcapi.ProveResponse, c vectorCase) (testVector, error) {
+	// Only the app hash is altered in place
+	header := *f.request.UntrustedHeader
+	header.AppHash = bytes.Clone(header.AppHash)
+	root := bytes.Clone(res.TrustedValidatorSetRoot)
+	evmProof := bytes.Clone(res.Proof.EvmProof)
+	if c.header != nil {
+		c.header(&header)
+	}
+	if c.root != nil {
+		c.root(root)
+	}
+	if c.proof != nil {
+		c.proof(evmProof)
+	}
+	inputsHash := lightClientInputsHash(header.ChainID, header.Height, header.Time, header.ValidatorsHash, header.NextValidatorsHash, header.AppHash, root)
+	valid := c.header == nil && c.root == nil && c.proof == nil
+	err := verify.VerifyEVMInputsHash(vk, evmProof, inputsHash)
+	if (err == nil) != valid {
+		return testVector{}, fmt.Errorf("Case %s of %s expected valid=%t, the verifier returned %v", c.name, fixtureName, valid, err)
+	}
+	return testVector{
+		Name:                  fixtureName + "-" + c.name,
+		Description:           c.description,
+		ChainID:               header.ChainID,
+		Height:                header.Height,
+		TimeSeconds:           header.Time.Unix(),
+		TimeNanos:             int32(header.Time.Nanosecond()),
+		ValidatorsHash:        header.ValidatorsHash,
+		NextValidatorsHash:    header.NextValidatorsHash,
+		AppHash:               header.AppHash,
+		TrustedValidatorsHash: root,
+		InputsHash:            inputsHash,
+		Proof:                 evmProof,
+		Valid:                 valid,
+	}, nil
+}
+
+func writeJSON(path string, v any) error {
+	bz, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(bz, '\n'), 0644)
+}
+
+func GenVectorsCmd() *cobra.Command {
+	var cmd = &cobra.Command{
+		Short: "Generate deterministic (inputs, proof, expected result) vectors, valid and tampered, for the test suites of the on-chain verifiers",
+		Use:   "gen-vectors",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := checkExpectedCircuitProfile(cmd); err != nil {
+				return err
+			}
+			r1csPath, err := cmd.Flags().GetString(flagR1CS)
+			if err != nil {
+				return err
+			}
+			pkPath, err := cmd.Flags().GetString(flagPK)
+			if err != nil {
+				return err
+			}
+			vkPath, err := cmd.Flags().GetString(flagVK)
+			if err != nil {
+				return err
+			}
+			out, err := cmd.Flags().GetString(flagOut)
+			if err != nil {
+				return err
+			}
+			seed, err := cmd.Flags().GetString(flagSeed)
+			if err != nil {
+				return err
+			}
+			nbOfFixtures, err := cmd.Flags().GetInt(flagFixtures)
+			if err != nil {
+				return err
+			}
+			nbOfValidators, err := cmd.Flags().GetInt(flagNbOfValidators)
+			if err != nil {
+				return err
+			}
+			if nbOfValidators < 1 || nbOfValidators > lightclient.MaxVal {
+				return fmt.Errorf("the number of validators must be between 1 and %d", lightclient.MaxVal)
+			}
+			logLevel, err := cmd.Flags().GetInt(flagLogLevel)
+			if err != nil {
+				return err
+			}
+			zerolog.SetGlobalLevel(zerolog.Level(logLevel))
+			logger.Disable()
+
+			server, err := provergrpc.NewProverServer(1, r1csPath, pkPath, vkPath)
+			if err != nil {
+				return err
+			}
+			vk, err := provergrpc.LoadVerifyingKey(vkPath)
+			if err != nil {
+				return err
+			}
+			ctx := context.Background()
+			info, err := server.GetInfo(ctx, &provergrpcapi.GetInfoRequest{})
+			if err != nil {
+				return err
+			}
+			if err := os.MkdirAll(out, 0755); err != nil {
+				return fmt.Errorf("Could not create output directory %s", err)
+			}
+
+			// Once the keys are loaded, or generated if missing, the validator
+			// keys, hashes and proof blinding factors are all drawn from
+			// crypto/rand.Reader, seeding it makes the vectors reproducible.
+			// Such proofs are not zero knowledge, which is irrelevant for
+			// public test vectors.
+			previousReader := rand.Reader
+			rand.Reader = mathrand.NewChaCha8(sha256.Sum256([]byte(seed)))
+			defer func() { rand.Reader = previousReader }()
+
+			manifest := vectorsManifest{Seed: seed, CircuitID: info.CircuitId}
+			for i := 0; i < nbOfFixtures; i++ {
+				f, err := newFixtureAt(nbOfValidators, vectorsEpoch.Add(time.Duration(i)*time.Minute))
+				if err != nil {
+					return err
+				}
+				res, err := server.Prove(ctx, f.request)
+				if err != nil {
+					return err
+				}
+				fixtureName := fmt.Sprintf("fixture-%d", i)
+				for _, c := range vectorCases {
+					vector, err := newTestVector(vk, fixtureName, f, res, c)
+					if err != nil {
+						return err
+					}
+					if err := writeJSON(filepath.Join(out, vector.Name+".json"), vector); err != nil {
+						return err
+					}
+					manifest.Vectors = append(manifest.Vectors, vector.Name)
+				}
+				fmt.Printf("Generated the vectors of %s\n", fixtureName)
+			}
+			return writeJSON(filepath.Join(out, "manifest.json"), manifest)
+		},
+	}
+	addExpectedCircuitProfileFlag(cmd)
+	cmd.Flags().String(flagR1CS, "r1cs.bin", "Path to the compiled R1CS circuit, generated along with dev keys if missing.")
+	cmd.Flags().String(flagPK, "pk.bin", "Path to the proving key.")
+	cmd.Flags().String(flagVK, "vk.bin", "Path to the verifying key.")
+	cmd.Flags().String(flagOut, "vectors", "Directory the vectors and their manifest are written to.")
+	cmd.Flags().String(flagSeed, "galoisd", "Seed of the randomness, the same seed and keys generating the same vectors.")
+	cmd.Flags().Int(flagFixtures, 2, "Number of generated requests, each giving a valid vector and its tampered variants.")
+	cmd.Flags().Int(flagNbOfValidators, 4, "Number of validators of the generated requests.")
+	cmd.Flags().Int(flagLogLevel, int(zerolog.WarnLevel), "Log level of the in-process prover.")
+	return cmd
+}
@@ -0,0 +1,201 @@
+package cmd
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	provergrpcapi "galois/grpc/api/v3"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	flagState = "state"
+	flagAdmin = "admin"
+)
+
+func JobsCmd() *cobra.Command {
+	var cmd = &cobra.Command{
+		Short: "Inspect the jobs of a running prover daemon",
+		Use:   "jobs",
+	}
+	cmd.AddCommand(JobsListCmd(), JobsGetCmd(), JobsCancelCmd())
+	return cmd
+}
+
+// Parse a job state as accepted on the command line, e.g. running or JOB_STATE_RUNNING.
+func parseJobState(s string) (provergrpcapi.JobState, error) {
+	name := strings.ToUpper(s)
+	if !strings.HasPrefix(name, "JOB_STATE_") {
+		name = "JOB_STATE_" + name
+	}
+	state, found := provergrpcapi.JobState_value[name]
+	if !found {
+		return 0, fmt.Errorf("Unknown job state %s", s)
+	}
+	return provergrpcapi.JobState(state), nil
+}
+
+func formatJobState(state provergrpcapi.JobState) string {
+	return strings.ToLower(strings.TrimPrefix(state.String(), "JOB_STATE_"))
+}
+
+func formatTimestamp(t *timestamppb.Timestamp) string {
+	if t == nil {
+		return "-"
+	}
+	return t.AsTime().Local().Format(time.DateTime)
+}
+
+func formatDuration(job *provergrpcapi.Job) string {
+	if job.StartedAt == nil {
+		return "-"
+	}
+	end := time.Now()
+	if job.FinishedAt != nil {
+		end = job.FinishedAt.AsTime()
+	}
+	return end.Sub(job.StartedAt.AsTime()).Round(time.Second).String()
+}
+
+func printJobs(jobs []*provergrpcapi.Job) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "REQUEST HASH\tSTATE\tOWNER\tSUBMITTED\tSTARTED\tDURATION\tINPUTS HASH")
+	for _, job := range jobs {
+		inputsHash := "-"
+		if len(job.InputsHash) > 0 {
+			inputsHash = hex.EncodeToString(job.InputsHash)
+		}
+		owner := job.Owner
+		if owner == "" {
+			owner = "-"
+		}
+		fmt.Fprintf(
+			w,
+			"%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			hex.EncodeToString(job.RequestHash),
+			formatJobState(job.State),
+			owner,
+			formatTimestamp(job.SubmittedAt),
+			formatTimestamp(job.StartedAt),
+			formatDuration(job),
+			inputsHash,
+		)
+	}
+	return w.Flush()
+}
+
+func JobsListCmd() *cobra.Command {
+	var cmd = &cobra.Command{
+		Short: "List the queued, running and completed jobs",
+		Use:   "list [uri]",
+		Args:  cobra.ExactArgs(1),
+		RunE: MakeAdminCobra(func(ctx context.Context, client provergrpcapi.UnionProverAdminAPIClient, cmd *cobra.Command, args []string) error {
+			rawStates, err := cmd.Flags().GetStringSlice(flagState)
+			if err != nil {
+				return err
+			}
+			req := &provergrpcapi.ListJobsRequest{}
+			for _, rawState := range rawStates {
+				state, err := parseJobState(rawState)
+				if err != nil {
+					return err
+				}
+				req.States = append(req.States, state)
+			}
+			res, err := client.ListJobs(ctx, req)
+			if err != nil {
+				return err
+			}
+			return printJobs(res.Jobs)
+		}),
+	}
+	cmd.Flags().String(flagTLS, "", "Whether the gRPC endpoint expect TLS.")
+	cmd.Flags().Bool(flagInsecureToken, false, "Send the GALOISD_TOKEN bearer token without TLS, e.g. to a proxy terminating TLS on the same host.")
+	cmd.Flags().StringSlice(flagState, nil, "Only list the jobs in the given states (queued, running, done, failed, cancelled).")
+	return cmd
+}
+
+func decodeRequestHash(s string) ([]byte, error) {
+	requestHash, err := hex.DecodeString(strings.TrimPrefix(s, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("Could not decode request hash %s", err)
+	}
+	return requestHash, nil
+}
+
+func JobsGetCmd() *cobra.Command {
+	var cmd = &cobra.Command{
+		Short: "Show a single job given its request hash",
+		Use:   "get [uri] [request_hash]",
+		Args:  cobra.ExactArgs(2),
+		RunE: MakeAdminCobra(func(ctx context.Context, client provergrpcapi.UnionProverAdminAPIClient, cmd *cobra.Command, args []string) error {
+			requestHash, err := decodeRequestHash(args[1])
+			if err != nil {
+				return err
+			}
+			res, err := client.GetJob(ctx, &provergrpcapi.GetJobRequest{
+				RequestHash: requestHash,
+			})
+			if err != nil {
+				return err
+			}
+			if err := printJobs([]*provergrpcapi.Job{res.Job}); err != nil {
+				return err
+			}
+			if res.Job.Error != "" {
+				fmt.Printf("\nerror: %s\n", res.Job.Error)
+			}
+			return nil
+		}),
+	}
+	cmd.Flags().String(flagTLS, "", "Whether the gRPC endpoint expect TLS.")
+	cmd.Flags().Bool(flagInsecureToken, false, "Send the GALOISD_TOKEN bearer token without TLS, e.g. to a proxy terminating TLS on the same host.")
+	return cmd
+}
+
+func JobsCancelCmd() *cobra.Command {
+	var cmd = &cobra.Command{
+		Short: "Cancel a queued or running job given its request hash",
+		Use:   "cancel [uri] [request_hash]",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			requestHash, err := decodeRequestHash(args[1])
+			if err != nil {
+				return err
+			}
+			admin, err := cmd.Flags().GetBool(flagAdmin)
+			if err != nil {
+				return err
+			}
+			req := &provergrpcapi.CancelJobRequest{
+				RequestHash: requestHash,
+			}
+			var res *provergrpcapi.CancelJobResponse
+			if admin {
+				err = MakeAdminCobra(func(ctx context.Context, client provergrpcapi.UnionProverAdminAPIClient, cmd *cobra.Command, args []string) error {
+					res, err = client.CancelJob(ctx, req)
+					return err
+				})(cmd, args)
+			} else {
+				err = MakeCobra(func(ctx context.Context, client provergrpcapi.UnionProverAPIClient, cmd *cobra.Command, args []string) error {
+					res, err = client.CancelJob(ctx, req)
+					return err
+				})(cmd, args)
+			}
+			if err != nil {
+				return err
+			}
+			return printJobs([]*provergrpcapi.Job{res.Job})
+		},
+	}
+	cmd.Flags().String(flagTLS, "", "Whether the gRPC endpoint expect TLS.")
+	cmd.Flags().Bool(flagInsecureToken, false, "Send the GALOISD_TOKEN bearer token without TLS, e.g. to a proxy terminating TLS on the same host.")
+	cmd.Flags().Bool(flagAdmin, false, "Go through the admin service, allowing to cancel jobs submitted by other clients.")
+	return cmd
+}
@@ -0,0 +1,255 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	provergrpc "galois/grpc/api/v3"
+	"galois/pkg/lightclient"
+	"math"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	flagTarget         = "target"
+	flagRPS            = "rps"
+	flagMaxInflight    = "max-inflight"
+	flagWait           = "wait"
+	flagPollInterval   = "poll-interval"
+	flagRequestTimeout = "request-timeout"
+	flagReportInterval = "report-interval"
+)
+
+type loadtestStats struct {
+	mu sync.Mutex
+	// Requests issued, and skipped because too many were in flight
+	sent    int
+	dropped int
+	// Latency of the submission and until the proof is done
+	submitted []time.Duration
+	completed []time.Duration
+	// By gRPC code, or job_failed if the prover failed to generate the proof
+	errors map[string]int
+}
+
+func (s *loadtestStats) fail(reason string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.errors[reason]++
+}
+
+func (s *loadtestStats) record(latencies *[]time.Duration, elapsed time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	*latencies = append(*latencies, elapsed)
+}
+
+func (s *loadtestStats) errorSummary() string {
+	reasons := make([]string, 0, len(s.errors))
+	for reason, count := range s.errors {
+		reasons = append(reasons, fmt.Sprintf("%s=%d", reason, count))
+	}
+	sort.Strings(reasons)
+	if len(reasons) == 0 {
+		return "none"
+	}
+	return strings.Join(reasons, " ")
+}
+
+func latencySummary(latencies []time.Duration) string {
+	if len(latencies) == 0 {
+		return "n/a"
+	}
+	sorted := append([]time.Duration(nil), latencies...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	percentile := func(q float64) time.Duration {
+		i := int(math.Ceil(q*float64(len(sorted)))) - 1
+		return sorted[max(i, 0)].Round(time.Millisecond)
+	}
+	return fmt.Sprintf("p50=%s p90=%s p99=%s max=%s", percentile(0.5), percentile(0.9), percentile(0.99), sorted[len(sorted)-1].Round(time.Millisecond))
+}
+
+// Submit a request and, if waiting, poll it until the proof is done.
+func loadtestRequest(ctx context.Context, client provergrpc.UnionProverAPIClient, req *provergrpc.ProveRequest, wait bool, pollInterval time.Duration, stats *loadtestStats) {
+	start := time.Now()
+	res, err := client.Poll(ctx, &provergrpc.PollRequest{Request: req})
+	if err != nil {
+		stats.fail(status.Code(err).String())
+		return
+	}
+	stats.record(&stats.submitted, time.Since(start))
+	if !wait {
+		return
+	}
+	for {
+		switch res.Result.(type) {
+		case *provergrpc.PollResponse_Done:
+			stats.record(&stats.completed, time.Since(start))
+			return
+		case *provergrpc.PollResponse_Failed:
+			stats.fail("job_failed")
+			return
+		}
+		select {
+		case <-ctx.Done():
+			stats.fail(status.FromContextError(ctx.Err()).Code().String())
+			return
+		case <-time.After(pollInterval):
+		}
+		res, err = client.Poll(ctx, &provergrpc.PollRequest{Request: req})
+		if err != nil {
+			stats.fail(status.Code(err).String())
+			return
+		}
+	}
+}
+
+func LoadtestCmd() *cobra.Command {
+	var cmd = &cobra.Command{
+		Short: "Submit synthetic but valid requests to a prover at a fixed rate and report the latency and error distributions",
+		Use:   "loadtest",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			target, err := cmd.Flags().GetString(flagTarget)
+			if err != nil {
+				return err
+			}
+			rps, err := cmd.Flags().GetFloat64(flagRPS)
+			if err != nil {
+				return err
+			}
+			duration, err := cmd.Flags().GetDuration(flagDuration)
+			if err != nil {
+				return err
+			}
+			maxInflight, err := cmd.Flags().GetInt(flagMaxInflight)
+			if err != nil {
+				return err
+			}
+			wait, err := cmd.Flags().GetBool(flagWait)
+			if err != nil {
+				return err
+			}
+			pollInterval, err := cmd.Flags().GetDuration(flagPollInterval)
+			if err != nil {
+				return err
+			}
+			requestTimeout, err := cmd.Flags().GetDuration(flagRequestTimeout)
+			if err != nil {
+				return err
+			}
+			reportInterval, err := cmd.Flags().GetDuration(flagReportInterval)
+			if err != nil {
+				return err
+			}
+			nbOfValidators, err := cmd.Flags().GetInt(flagNbOfValidators)
+			if err != nil {
+				return err
+			}
+			if target == "" {
+				return fmt.Errorf("--%s is required", flagTarget)
+			}
+			if rps <= 0 {
+				return fmt.Errorf("The rate must be positive")
+			}
+			if nbOfValidators < 1 || nbOfValidators > lightclient.MaxVal {
+				return fmt.Errorf("the number of validators must be between 1 and %d", lightclient.MaxVal)
+			}
+
+			conn := dial(cmd, target)
+			defer conn.Close()
+			client := provergrpc.NewUnionProverAPIClient(conn)
+
+			ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+			defer cancel()
+
+			stats := &loadtestStats{errors: make(map[string]int)}
+			report := func() {
+				stats.mu.Lock()
+				defer stats.mu.Unlock()
+				fmt.Printf("sent=%d dropped=%d submitted=%d completed=%d errors: %s\n", stats.sent, stats.dropped, len(stats.submitted), len(stats.completed), stats.errorSummary())
+			}
+
+			// Open loop: requests are issued at the target rate whatever the
+			// latency of the prover, like independent relayers would.
+			ticker := time.NewTicker(time.Duration(float64(time.Second) / rps))
+			defer ticker.Stop()
+			reports := time.NewTicker(reportInterval)
+			defer reports.Stop()
+			end := time.After(duration)
+			inflight := make(chan struct{}, maxInflight)
+			var wg sync.WaitGroup
+		loop:
+			for {
+				select {
+				case <-ctx.Done():
+					break loop
+				case <-end:
+					break loop
+				case <-reports.C:
+					report()
+				case <-ticker.C:
+					select {
+					case inflight <- struct{}{}:
+					default:
+						stats.mu.Lock()
+						stats.dropped++
+						stats.mu.Unlock()
+						continue
+					}
+					stats.mu.Lock()
+					stats.sent++
+					stats.mu.Unlock()
+					wg.Add(1)
+					go func() {
+						defer func() {
+							<-inflight
+							wg.Done()
+						}()
+						// Fresh validators and hashes, such that every
+						// request is a new job
+						f, err := newFixture(nbOfValidators)
+						if err != nil {
+							stats.fail("fixture")
+							return
+						}
+						reqCtx, reqCancel := context.WithTimeout(ctx, requestTimeout)
+						defer reqCancel()
+						loadtestRequest(reqCtx, client, f.request, wait, pollInterval, stats)
+					}()
+				}
+			}
+			fmt.Println("Waiting for the requests in flight...")
+			wg.Wait()
+
+			report()
+			stats.mu.Lock()
+			defer stats.mu.Unlock()
+			fmt.Printf("submit latency: %s\n", latencySummary(stats.submitted))
+			if wait {
+				fmt.Printf("proof latency: %s\n", latencySummary(stats.completed))
+			}
+			return nil
+		},
+	}
+	cmd.Flags().String(flagTarget, "", "URI of the prover under test.")
+	cmd.Flags().String(flagTLS, "", "Whether the gRPC endpoint expect TLS.")
+	cmd.Flags().Bool(flagInsecureToken, false, "Send the GALOISD_TOKEN bearer token without TLS, e.g. to a proxy terminating TLS on the same host.")
+	cmd.Flags().Float64(flagRPS, 1, "Number of requests submitted per second.")
+	cmd.Flags().Duration(flagDuration, time.Minute, "How long requests are submitted for.")
+	cmd.Flags().Int(flagMaxInflight, 1000, "Maximum number of requests in flight, the requests due above it are dropped and counted as such.")
+	cmd.Flags().Bool(flagWait, true, "Whether to poll every request until its proof is done, measuring the proof latency. Otherwise, only the submission is measured.")
+	cmd.Flags().Duration(flagPollInterval, time.Second, "Delay between two polls of a pending request.")
+	cmd.Flags().Duration(flagRequestTimeout, 30*time.Minute, "Time after which a request is abandoned.")
+	cmd.Flags().Duration(flagReportInterval, 10*time.Second, "How often the progress is printed.")
+	cmd.Flags().Int(flagNbOfValidators, 4, "Number of validators of the generated requests.")
+	return cmd
+}
@@ -0,0 +1,87 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	provergrpcapi "galois/grpc/api/v3"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	flagStartIn     = "start-in"
+	flagDuration    = "duration"
+	flagDrainBefore = "drain-before"
+)
+
+func MaintenanceCmd() *cobra.Command {
+	var cmd = &cobra.Command{
+		Short: "Schedule the maintenance of a running prover daemon",
+		Use:   "maintenance",
+	}
+	cmd.AddCommand(MaintenanceScheduleCmd(), MaintenanceCancelCmd())
+	return cmd
+}
+
+func MaintenanceScheduleCmd() *cobra.Command {
+	var cmd = &cobra.Command{
+		Short: "Schedule a maintenance window, the prover drains ahead of it and rejects new jobs until it ends",
+		Use:   "schedule [uri]",
+		Args:  cobra.ExactArgs(1),
+		RunE: MakeAdminCobra(func(ctx context.Context, client provergrpcapi.UnionProverAdminAPIClient, cmd *cobra.Command, args []string) error {
+			startIn, err := cmd.Flags().GetDuration(flagStartIn)
+			if err != nil {
+				return err
+			}
+			duration, err := cmd.Flags().GetDuration(flagDuration)
+			if err != nil {
+				return err
+			}
+			drainBefore, err := cmd.Flags().GetDuration(flagDrainBefore)
+			if err != nil {
+				return err
+			}
+			start := time.Now().Add(startIn)
+			res, err := client.ScheduleMaintenance(ctx, &provergrpcapi.ScheduleMaintenanceRequest{
+				Window: &provergrpcapi.MaintenanceWindow{
+					DrainAt: timestamppb.New(start.Add(-drainBefore)),
+					Start:   timestamppb.New(start),
+					End:     timestamppb.New(start.Add(duration)),
+				},
+			})
+			if err != nil {
+				return err
+			}
+			bz, err := json.Marshal(res.Window)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(bz))
+			return nil
+		}),
+	}
+	cmd.Flags().String(flagTLS, "", "Whether the gRPC endpoint expect TLS.")
+	cmd.Flags().Bool(flagInsecureToken, false, "Send the GALOISD_TOKEN bearer token without TLS, e.g. to a proxy terminating TLS on the same host.")
+	cmd.Flags().Duration(flagStartIn, 0, "Delay before the maintenance starts.")
+	cmd.Flags().Duration(flagDuration, 30*time.Minute, "Duration of the maintenance.")
+	cmd.Flags().Duration(flagDrainBefore, 10*time.Minute, "How long before the start the prover advertises it is draining and stops accepting jobs, such that the running ones complete.")
+	return cmd
+}
+
+func MaintenanceCancelCmd() *cobra.Command {
+	var cmd = &cobra.Command{
+		Short: "Cancel the scheduled maintenance, the prover accepts jobs again",
+		Use:   "cancel [uri]",
+		Args:  cobra.ExactArgs(1),
+		RunE: MakeAdminCobra(func(ctx context.Context, client provergrpcapi.UnionProverAdminAPIClient, cmd *cobra.Command, args []string) error {
+			_, err := client.CancelMaintenance(ctx, &provergrpcapi.CancelMaintenanceRequest{})
+			return err
+		}),
+	}
+	cmd.Flags().String(flagTLS, "", "Whether the gRPC endpoint expect TLS.")
+	cmd.Flags().Bool(flagInsecureToken, false, "Send the GALOISD_TOKEN bearer token without TLS, e.g. to a proxy terminating TLS on the same host.")
+	return cmd
+}
@@ -0,0 +1,94 @@
+package cmd
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	provergrpc "galois/grpc/api/v3"
+	"galois/pkg/ibcmsg"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagOutputFormat  = "output-format"
+	flagClientID      = "client-id"
+	flagSigner        = "signer"
+	flagTrustedHeight = "trusted-height"
+
+	// Human readable fields of the response
+	outputText = "text"
+	// Cometbls header, as the client message of a MsgUpdateClient
+	outputHeader = "header"
+	// MsgUpdateClient wrapped in an Any, as a message of a transaction body
+	outputMsgUpdateClient = "msg-update-client"
+)
+
+func addOutputFormatFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagOutputFormat, outputText, "Format of the proof, either text, header (hex encoded cometbls header) or msg-update-client (hex encoded MsgUpdateClient wrapped in an Any).")
+	cmd.Flags().String(flagClientID, "", "IBC client updated by the msg-update-client output.")
+	cmd.Flags().String(flagSigner, "", "Address of the relayer signing the msg-update-client output.")
+	cmd.Flags().String(flagTrustedHeight, "", "Height of the client the header updates from, as revision-height. The revision defaults to the one of the chain ID.")
+}
+
+func outputFormat(cmd *cobra.Command) (string, error) {
+	format, err := cmd.Flags().GetString(flagOutputFormat)
+	if err != nil {
+		return "", err
+	}
+	switch format {
+	case outputText, outputHeader, outputMsgUpdateClient:
+		return format, nil
+	default:
+		return "", fmt.Errorf("Unknown output format %s", format)
+	}
+}
+
+func trustedHeight(cmd *cobra.Command, req *provergrpc.ProveRequest) (ibcmsg.Height, error) {
+	raw, err := cmd.Flags().GetString(flagTrustedHeight)
+	if err != nil {
+		return ibcmsg.Height{}, err
+	}
+	if raw == "" {
+		return ibcmsg.Height{}, fmt.Errorf("The --%s flag is required to encode the header", flagTrustedHeight)
+	}
+	if strings.Contains(raw, "-") {
+		return ibcmsg.ParseHeight(raw)
+	}
+	// A bare height takes the revision of the chain
+	revisionHeight, err := strconv.ParseUint(raw, 10, 64)
+	if err != nil {
+		return ibcmsg.Height{}, fmt.Errorf("Could not parse trusted height %s", err)
+	}
+	return ibcmsg.Height{RevisionNumber: ibcmsg.RevisionNumber(req.Vote.ChainID), RevisionHeight: revisionHeight}, nil
+}
+
+// Encode the proof of a request as an IBC message, hex encoded.
+func formatIBCMessage(cmd *cobra.Command, format string, req *provergrpc.ProveRequest, res *provergrpc.ProveResponse) (string, error) {
+	height, err := trustedHeight(cmd, req)
+	if err != nil {
+		return "", err
+	}
+	header, err := ibcmsg.Header(req.UntrustedHeader, height, res.Proof.EvmProof)
+	if err != nil {
+		return "", err
+	}
+	if format == outputHeader {
+		return hex.EncodeToString(header), nil
+	}
+	clientID, err := cmd.Flags().GetString(flagClientID)
+	if err != nil {
+		return "", err
+	}
+	signer, err := cmd.Flags().GetString(flagSigner)
+	if err != nil {
+		return "", err
+	}
+	msg, err := ibcmsg.MsgUpdateClient(clientID, header, signer)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(ibcmsg.Any(ibcmsg.MsgUpdateClientTypeURL, msg)), nil
+}
@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+)
+
+const flagProfile = "profile"
+
+// Defaults of the serve flags by deployment environment. Only the flags not
+// given explicitly are taken from the profile.
+var deploymentProfiles = map[string]map[string]string{
+	// A single developer on a laptop: verbose, lenient and without metrics
+	"dev": {
+		flagLogLevel:          strconv.Itoa(int(zerolog.DebugLevel)),
+		flagMaxQueuedJobs:     "16",
+		flagSlowClientTimeout: "0",
+		flagMetricsAddr:       "",
+	},
+	// Production like limits, slow clients are only reported
+	"staging": {
+		flagLogLevel:             strconv.Itoa(int(zerolog.InfoLevel)),
+		flagMaxQueuedJobs:        "64",
+		flagAcceptRate:           "10",
+		flagAcceptTimeout:        "1m",
+		flagSlowClientTimeout:    "30s",
+		flagSlowClientDisconnect: "false",
+		flagResultMaxAge:         "24h",
+		flagProofAuditInterval:   "1h",
+		flagMetricsAddr:          ":9090",
+	},
+	"prod": {
+		flagLogLevel:             strconv.Itoa(int(zerolog.InfoLevel)),
+		flagMaxQueuedJobs:        "64",
+		flagAcceptRate:           "10",
+		flagAcceptTimeout:        "1m",
+		flagSlowClientTimeout:    "30s",
+		flagSlowClientDisconnect: "true",
+		flagResultMaxAge:         "24h",
+		flagProofAuditInterval:   "10m",
+		flagMetricsAddr:          ":9090",
+	},
+}
+
+func profileNames() []string {
+	names := make([]string, 0, len(deploymentProfiles))
+	for name := range deploymentProfiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func addProfileFlag(cmd *cobra.Command) {
+	cmd.Flags().String(flagProfile, "", fmt.Sprintf("Deployment environment whose defaults (log level, limits, timeouts, metrics) apply to the flags not given explicitly, one of %s. The flag defaults apply if empty.", strings.Join(profileNames(), ", ")))
+}
+
+// Set the flags not given explicitly to the defaults of the selected profile.
+func applyProfile(cmd *cobra.Command) error {
+	name, err := cmd.Flags().GetString(flagProfile)
+	if err != nil {
+		return err
+	}
+	if name == "" {
+		return nil
+	}
+	profile, found := deploymentProfiles[name]
+	if !found {
+		return fmt.Errorf("Unknown profile %s, expected one of %s", name, strings.Join(profileNames(), ", "))
+	}
+	for flag, value := range profile {
+		if cmd.Flags().Changed(flag) {
+			continue
+		}
+		if err := cmd.Flags().Set(flag, value); err != nil {
+			return fmt.Errorf("Could not apply the %s profile to --%s %s", name, flag, err)
+		}
+	}
+	return nil
+}
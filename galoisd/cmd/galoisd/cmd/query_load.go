@@ -0,0 +1,34 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	provergrpc "galois/grpc/api/v3"
+	"log"
+
+	"github.com/spf13/cobra"
+)
+
+func QueryLoad() *cobra.Command {
+	var cmd = &cobra.Command{
+		Short: "Query the load of the prover (running jobs, estimated wait, suggested retry delay)",
+		Use:   "query-load [uri]",
+		Args:  cobra.ExactArgs(1),
+		RunE: MakeCobra(func(ctx context.Context, client provergrpc.UnionProverAPIClient, cmd *cobra.Command, args []string) error {
+			res, err := client.GetLoad(ctx, &provergrpc.GetLoadRequest{})
+			if err != nil {
+				log.Fatal(err)
+			}
+			bz, err := json.Marshal(res)
+			if err != nil {
+				log.Fatal(err)
+			}
+			fmt.Println(string(bz))
+			return nil
+		}),
+	}
+	cmd.Flags().String(flagTLS, "", "Whether the gRPC endpoint expect TLS.")
+	cmd.Flags().Bool(flagInsecureToken, false, "Send the GALOISD_TOKEN bearer token without TLS, e.g. to a proxy terminating TLS on the same host.")
+	return cmd
+}
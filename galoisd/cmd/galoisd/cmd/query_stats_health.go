@@ -69,5 +69,6 @@ func QueryStatsHealth() *cobra.Command {
 
 	cmd.Flags().IntVar(&port, "port", 9999, "Port to run the health check server on")
 	cmd.Flags().String(flagTLS, "", "Whether the gRPC endpoint expects TLS.")
+	cmd.Flags().Bool(flagInsecureToken, false, "Send the GALOISD_TOKEN bearer token without TLS, e.g. to a proxy terminating TLS on the same host.")
 	return cmd
 }
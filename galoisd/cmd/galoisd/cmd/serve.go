@@ -1,11 +1,25 @@
 package cmd
 
 import (
+	"crypto/tls"
 	"fmt"
 	provergrpc "galois/grpc"
 	provergrpcapi "galois/grpc/api/v3"
-	"net"
+	"galois/pkg/authz"
+	"galois/pkg/clockskew"
+	"galois/pkg/entropy"
+	"galois/pkg/listener"
+	"galois/pkg/sandbox"
+	"galois/pkg/storage"
+	"math"
 	"os"
+	"os/signal"
+	"os/user"
+	"path/filepath"
+	"runtime/debug"
+	"strconv"
+	"strings"
+	"syscall"
 	"time"
 
 	"github.com/consensys/gnark/logger"
@@ -13,9 +27,10 @@ import (
 	"github.com/rs/zerolog/log"
 
 	"github.com/spf13/cobra"
-	"golang.org/x/net/netutil"
+	"github.com/spf13/pflag"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
 const (
@@ -24,14 +39,93 @@ const (
 	flagVK       = "vk-path"
 	flagMaxConn  = "max-conn"
 	flagLogLevel = "log-level"
+
+	flagPreviousR1CS   = "previous-cs-path"
+	flagPreviousPK     = "previous-pk-path"
+	flagPreviousVK     = "previous-vk-path"
+	flagRolloverWindow = "rollover-window"
+
+	flagGCPercent   = "gc-percent"
+	flagMemoryLimit = "memory-limit"
+
+	flagSnapshotPath   = "snapshot-path"
+	flagProofOutputDir = "proof-output-dir"
+	flagInputsDir      = "inputs-dir"
+	flagStorage        = "storage"
+
+	flagAcceptRate    = "accept-rate"
+	flagAcceptBurst   = "accept-burst"
+	flagAcceptTimeout = "accept-timeout"
+	flagAcceptBacklog = "accept-backlog"
+	flagMetricsAddr   = "metrics-addr"
+	flagAdminAddr     = "admin-addr"
+
+	flagSlowClientTimeout    = "slow-client-timeout"
+	flagSlowClientMinRate    = "slow-client-min-rate"
+	flagSlowClientDisconnect = "slow-client-disconnect"
+
+	flagNetwork = "network"
+
+	flagCrossCheckURI  = "cross-check-uri"
+	flagCrossCheckRate = "cross-check-rate"
+	flagCrossCheckTLS  = "cross-check-tls"
+
+	flagTLSCert           = "tls-cert"
+	flagTLSKey            = "tls-key"
+	flagTLSReloadInterval = "tls-reload-interval"
+
+	flagEntropySource = "entropy-source"
+
+	flagMaxQueuedJobs = "max-queued-jobs"
+	flagQueueWeights  = "queue-weights"
+	flagTenantMaxJobs = "tenant-max-jobs"
+
+	flagCircuitReservations = "circuit-reservations"
+
+	flagResultMaxAge       = "result-max-age"
+	flagResultHeightWindow = "result-height-window"
+
+	flagFreshnessWindow  = "freshness-window"
+	flagMonotonicHeights = "monotonic-heights"
+
+	flagNTPServer          = "ntp-server"
+	flagMaxClockSkew       = "max-clock-skew"
+	flagClockCheckInterval = "clock-check-interval"
+
+	flagProofAuditInterval = "proof-audit-interval"
+	flagProofAuditSample   = "proof-audit-sample"
+
+	flagMaxRequestBytes = "max-request-bytes"
+
+	flagAuthzPolicy         = "authz-policy"
+	flagAuthzReloadInterval = "authz-reload-interval"
+
+	flagSandbox        = "sandbox"
+	flagSandboxUser    = "sandbox-user"
+	flagSandboxDataDir = "sandbox-data-dir"
 )
 
-func ServeCmd() *cobra.Command {
+const mib = 1024 * 1024
+
+// Time given to the NTP servers to answer a check.
+const ntpTimeout = 5 * time.Second
+
+func ServeCmd(opts ...ServeOption) *cobra.Command {
+	var options serveOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
 	var cmd = &cobra.Command{
 		Short: "Expose the prover daemon to the network as a gRPC endpoint",
-		Use:   "serve [uri]",
-		Args:  cobra.ExactArgs(1),
+		Use:   "serve [uri...]",
+		Args:  cobra.MinimumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := applyProfile(cmd); err != nil {
+				return err
+			}
+			if err := checkExpectedCircuitProfile(cmd); err != nil {
+				return err
+			}
 			r1csPath, err := cmd.Flags().GetString(flagR1CS)
 			if err != nil {
 				return err
@@ -44,6 +138,22 @@ func ServeCmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			previousR1CSPath, err := cmd.Flags().GetString(flagPreviousR1CS)
+			if err != nil {
+				return err
+			}
+			previousPKPath, err := cmd.Flags().GetString(flagPreviousPK)
+			if err != nil {
+				return err
+			}
+			previousVKPath, err := cmd.Flags().GetString(flagPreviousVK)
+			if err != nil {
+				return err
+			}
+			rolloverWindow, err := cmd.Flags().GetDuration(flagRolloverWindow)
+			if err != nil {
+				return err
+			}
 			maxConn, err := cmd.Flags().GetInt(flagMaxConn)
 			if err != nil {
 				return err
@@ -52,38 +162,460 @@ func ServeCmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			snapshotPath, err := cmd.Flags().GetString(flagSnapshotPath)
+			if err != nil {
+				return err
+			}
+			proofOutputDir, err := cmd.Flags().GetString(flagProofOutputDir)
+			if err != nil {
+				return err
+			}
+			inputsDir, err := cmd.Flags().GetString(flagInputsDir)
+			if err != nil {
+				return err
+			}
+			acceptRate, err := cmd.Flags().GetFloat64(flagAcceptRate)
+			if err != nil {
+				return err
+			}
+			acceptBurst, err := cmd.Flags().GetInt(flagAcceptBurst)
+			if err != nil {
+				return err
+			}
+			acceptTimeout, err := cmd.Flags().GetDuration(flagAcceptTimeout)
+			if err != nil {
+				return err
+			}
+			acceptBacklog, err := cmd.Flags().GetInt(flagAcceptBacklog)
+			if err != nil {
+				return err
+			}
+			slowClientTimeout, err := cmd.Flags().GetDuration(flagSlowClientTimeout)
+			if err != nil {
+				return err
+			}
+			slowClientMinRate, err := cmd.Flags().GetFloat64(flagSlowClientMinRate)
+			if err != nil {
+				return err
+			}
+			slowClientDisconnect, err := cmd.Flags().GetBool(flagSlowClientDisconnect)
+			if err != nil {
+				return err
+			}
+			metricsAddr, err := cmd.Flags().GetString(flagMetricsAddr)
+			if err != nil {
+				return err
+			}
+			adminAddr, err := cmd.Flags().GetString(flagAdminAddr)
+			if err != nil {
+				return err
+			}
+			storageURI, err := cmd.Flags().GetString(flagStorage)
+			if err != nil {
+				return err
+			}
+			tlsCert, err := cmd.Flags().GetString(flagTLSCert)
+			if err != nil {
+				return err
+			}
+			tlsKey, err := cmd.Flags().GetString(flagTLSKey)
+			if err != nil {
+				return err
+			}
+			tlsReloadInterval, err := cmd.Flags().GetDuration(flagTLSReloadInterval)
+			if err != nil {
+				return err
+			}
+			crossCheckURI, err := cmd.Flags().GetString(flagCrossCheckURI)
+			if err != nil {
+				return err
+			}
+			crossCheckRate, err := cmd.Flags().GetFloat64(flagCrossCheckRate)
+			if err != nil {
+				return err
+			}
+			crossCheckTLS, err := cmd.Flags().GetBool(flagCrossCheckTLS)
+			if err != nil {
+				return err
+			}
+			network, err := cmd.Flags().GetString(flagNetwork)
+			if err != nil {
+				return err
+			}
+			entropySource, err := cmd.Flags().GetString(flagEntropySource)
+			if err != nil {
+				return err
+			}
+			sandboxEnabled, err := cmd.Flags().GetBool(flagSandbox)
+			if err != nil {
+				return err
+			}
+			sandboxUser, err := cmd.Flags().GetString(flagSandboxUser)
+			if err != nil {
+				return err
+			}
+			sandboxDataDirs, err := cmd.Flags().GetStringSlice(flagSandboxDataDir)
+			if err != nil {
+				return err
+			}
+			maxQueuedJobs, err := cmd.Flags().GetInt(flagMaxQueuedJobs)
+			if err != nil {
+				return err
+			}
+			queueWeightsPath, err := cmd.Flags().GetString(flagQueueWeights)
+			if err != nil {
+				return err
+			}
+			tenantMaxJobs, err := cmd.Flags().GetInt(flagTenantMaxJobs)
+			if err != nil {
+				return err
+			}
+			circuitReservationsPath, err := cmd.Flags().GetString(flagCircuitReservations)
+			if err != nil {
+				return err
+			}
+			resultMaxAge, err := cmd.Flags().GetDuration(flagResultMaxAge)
+			if err != nil {
+				return err
+			}
+			resultHeightWindow, err := cmd.Flags().GetInt64(flagResultHeightWindow)
+			if err != nil {
+				return err
+			}
+			freshnessWindow, err := cmd.Flags().GetDuration(flagFreshnessWindow)
+			if err != nil {
+				return err
+			}
+			monotonicHeights, err := cmd.Flags().GetBool(flagMonotonicHeights)
+			if err != nil {
+				return err
+			}
+			ntpServers, err := cmd.Flags().GetStringSlice(flagNTPServer)
+			if err != nil {
+				return err
+			}
+			maxClockSkew, err := cmd.Flags().GetDuration(flagMaxClockSkew)
+			if err != nil {
+				return err
+			}
+			clockCheckInterval, err := cmd.Flags().GetDuration(flagClockCheckInterval)
+			if err != nil {
+				return err
+			}
+			proofAuditInterval, err := cmd.Flags().GetDuration(flagProofAuditInterval)
+			if err != nil {
+				return err
+			}
+			proofAuditSample, err := cmd.Flags().GetInt(flagProofAuditSample)
+			if err != nil {
+				return err
+			}
+			maxRequestBytes, err := cmd.Flags().GetInt(flagMaxRequestBytes)
+			if err != nil {
+				return err
+			}
+			authzPolicy, err := cmd.Flags().GetString(flagAuthzPolicy)
+			if err != nil {
+				return err
+			}
+			authzReloadInterval, err := cmd.Flags().GetDuration(flagAuthzReloadInterval)
+			if err != nil {
+				return err
+			}
+			if network != "tcp" && network != "tcp4" && network != "tcp6" {
+				return fmt.Errorf("Network must be one of tcp, tcp4 or tcp6")
+			}
+			rolloverEnabled := previousR1CSPath != "" || previousPKPath != "" || previousVKPath != ""
+			if crossCheckRate < 0 || crossCheckRate > 1 {
+				return fmt.Errorf("Cross check rate must be between 0 and 1")
+			}
+			if rolloverEnabled && (previousR1CSPath == "" || previousPKPath == "" || previousVKPath == "") {
+				return fmt.Errorf("All of --%s, --%s and --%s must be provided to serve the previous circuit", flagPreviousR1CS, flagPreviousPK, flagPreviousVK)
+			}
+			if (tlsCert == "") != (tlsKey == "") {
+				return fmt.Errorf("Both --%s and --%s must be provided to enable TLS", flagTLSCert, flagTLSKey)
+			}
 			if logLevel > int(zerolog.PanicLevel) || logLevel < int(zerolog.TraceLevel) {
 				return fmt.Errorf("log level must be between TraceLevel and PanicLevel")
 			}
 			zerolog.SetGlobalLevel(zerolog.Level(logLevel))
 			log.Logger = log.With().Caller().Logger().Output(os.Stdout)
 			logger.Set(log.Logger)
-			uri := args[0]
-			lis, err := net.Listen("tcp", uri)
-			if err != nil {
-				return err
+			// The MSM/FFT scratch buffers are allocated by gnark itself, we can't
+			// pool them from here. What we can do is trade memory for fewer GC
+			// cycles while proofs are being computed.
+			if cmd.Flags().Changed(flagGCPercent) {
+				gcPercent, err := cmd.Flags().GetInt(flagGCPercent)
+				if err != nil {
+					return err
+				}
+				debug.SetGCPercent(gcPercent)
+			}
+			if cmd.Flags().Changed(flagMemoryLimit) {
+				memoryLimit, err := cmd.Flags().GetInt64(flagMemoryLimit)
+				if err != nil {
+					return err
+				}
+				switch {
+				case memoryLimit < 0 || memoryLimit > math.MaxInt64/mib:
+					return fmt.Errorf("The memory limit must be between 0 and %d MiB", math.MaxInt64/mib)
+				case memoryLimit == 0:
+					// As without a limit, collecting continuously otherwise
+					debug.SetMemoryLimit(math.MaxInt64)
+				default:
+					debug.SetMemoryLimit(memoryLimit * mib)
+				}
 			}
-			limitedLis := netutil.LimitListener(lis, maxConn)
-			grpcServer := grpc.NewServer(grpc.KeepaliveParams(keepalive.ServerParameters{
-				MaxConnectionIdle:     10 * time.Second,
-				MaxConnectionAge:      5 * time.Minute,
-				MaxConnectionAgeGrace: time.Second,
-				Time:                  5 * time.Second,
-				Timeout:               20 * time.Second,
-			}))
-			server, err := provergrpc.NewProverServer(uint32(maxConn), r1csPath, pkPath, vkPath)
+			if entropySource != "" {
+				source, err := entropy.Open(entropySource)
+				if err != nil {
+					return err
+				}
+				entropy.Install(source)
+				log.Info().Str("path", entropySource).Msg("Entropy source installed")
+			}
+			store, err := storage.Open(storageURI)
 			if err != nil {
 				return err
 			}
-			provergrpcapi.RegisterUnionProverAPIServer(grpcServer, server)
-			log.Info().Msg("Serving...")
-			return grpcServer.Serve(limitedLis)
+			proverOptions := append([]provergrpc.Option{provergrpc.WithInputsDir(inputsDir)}, options.proverOptions...)
+			if rolloverEnabled {
+				proverOptions = append(proverOptions, provergrpc.WithPreviousKeys(previousR1CSPath, previousPKPath, previousVKPath, rolloverWindow))
+			}
+			if crossCheckURI != "" {
+				creds := insecure.NewCredentials()
+				if crossCheckTLS {
+					creds = credentials.NewTLS(&tls.Config{})
+				}
+				conn, err := grpc.Dial(crossCheckURI, grpc.WithTransportCredentials(creds))
+				if err != nil {
+					return fmt.Errorf("Could not dial cross check verifier %s", err)
+				}
+				defer conn.Close()
+				proverOptions = append(proverOptions, provergrpc.WithCrossCheck(provergrpcapi.NewUnionProverAPIClient(conn), crossCheckRate))
+			}
+			if proofOutputDir != "" {
+				proverOptions = append(proverOptions, provergrpc.WithProofOutputDir(proofOutputDir))
+			}
+			if maxQueuedJobs > 0 {
+				var weights provergrpc.QueueWeights
+				if queueWeightsPath != "" {
+					weights, err = provergrpc.ReadQueueWeights(queueWeightsPath)
+					if err != nil {
+						return err
+					}
+				}
+				proverOptions = append(proverOptions, provergrpc.WithQueue(maxQueuedJobs, weights))
+			}
+			if tenantMaxJobs > 0 {
+				proverOptions = append(proverOptions, provergrpc.WithTenantMaxJobs(tenantMaxJobs))
+			}
+			if circuitReservationsPath != "" {
+				reservations, err := provergrpc.ReadReservations(circuitReservationsPath)
+				if err != nil {
+					return err
+				}
+				proverOptions = append(proverOptions, provergrpc.WithReservations(reservations))
+			}
+			if resultMaxAge > 0 || resultHeightWindow >= 0 {
+				proverOptions = append(proverOptions, provergrpc.WithResultRetention(resultMaxAge, resultHeightWindow))
+			}
+			if freshnessWindow > 0 || monotonicHeights {
+				proverOptions = append(proverOptions, provergrpc.WithFreshness(freshnessWindow, monotonicHeights))
+			}
+			if len(ntpServers) > 0 {
+				monitor := clockskew.New(ntpServers, maxClockSkew, ntpTimeout)
+				proverOptions = append(proverOptions, provergrpc.WithClockMonitor(monitor, clockCheckInterval))
+			}
+			if proofAuditInterval > 0 {
+				proverOptions = append(proverOptions, provergrpc.WithProofAudit(proofAuditInterval, proofAuditSample))
+			}
+			unaryInterceptors := options.unaryInterceptors
+			streamInterceptors := options.streamInterceptors
+			var authorizer *authz.Authorizer
+			if authzPolicy != "" {
+				authorizer, err = authz.New(authzPolicy)
+				if err != nil {
+					return err
+				}
+				unaryInterceptors = append([]grpc.UnaryServerInterceptor{authorizer.UnaryServerInterceptor()}, unaryInterceptors...)
+				streamInterceptors = append([]grpc.StreamServerInterceptor{authorizer.StreamServerInterceptor()}, streamInterceptors...)
+				log.Info().Str("policy", authzPolicy).Msg("Authorization enabled")
+			}
+			var sandboxCfg *sandbox.Config
+			if sandboxEnabled {
+				readOnlyFiles := []string{tlsCert, tlsKey, authzPolicy}
+				// Loaded again by ctl reload-keys
+				for _, key := range []string{r1csPath, pkPath, vkPath} {
+					if file, ok := storage.LocalPath(store, key); ok {
+						readOnlyFiles = append(readOnlyFiles, file)
+					}
+				}
+				cfg, err := sandboxConfig(sandboxUser, sandboxDataDirs, readOnlyFiles...)
+				if err != nil {
+					return err
+				}
+				sandboxCfg = &cfg
+			}
+
+			settings := map[string]string{"uris": strings.Join(args, ",")}
+			cmd.Flags().VisitAll(func(f *pflag.Flag) {
+				settings[f.Name] = f.Value.String()
+			})
+
+			ctx, cancel := signal.NotifyContext(cmd.Context(), syscall.SIGINT, syscall.SIGTERM)
+			defer cancel()
+			tlsReload := make(chan struct{}, 1)
+			hangups := make(chan os.Signal, 1)
+			signal.Notify(hangups, syscall.SIGHUP)
+			defer signal.Stop(hangups)
+			if authorizer != nil && authzReloadInterval > 0 {
+				go authorizer.Watch(ctx, authzReloadInterval)
+			}
+			go func() {
+				for range hangups {
+					if authorizer != nil {
+						authorizer.ReloadAndLog()
+					}
+					select {
+					case tlsReload <- struct{}{}:
+					default:
+					}
+				}
+			}()
+
+			return provergrpc.Serve(ctx, provergrpc.Config{
+				Network:   network,
+				Addresses: args,
+				AdminAddr: adminAddr,
+				Accept: listener.Config{
+					Rate:    acceptRate,
+					Burst:   acceptBurst,
+					MaxConn: maxConn,
+					Backlog: acceptBacklog,
+					Timeout: acceptTimeout,
+				},
+				SlowClients: listener.SlowClientConfig{
+					Timeout:    slowClientTimeout,
+					MinRate:    slowClientMinRate,
+					Disconnect: slowClientDisconnect,
+				},
+				R1CSPath:          r1csPath,
+				PKPath:            pkPath,
+				VKPath:            vkPath,
+				Storage:           store,
+				SnapshotPath:      snapshotPath,
+				TLSCert:           tlsCert,
+				TLSKey:            tlsKey,
+				TLSReloadInterval: tlsReloadInterval,
+				TLSReload:         tlsReload,
+				MetricsAddr:       metricsAddr,
+				Sandbox:           sandboxCfg,
+				Settings:          settings,
+				MaxRequestBytes:   maxRequestBytes,
+				ServerOptions: append([]grpc.ServerOption{
+					grpc.ChainUnaryInterceptor(unaryInterceptors...),
+					grpc.ChainStreamInterceptor(streamInterceptors...),
+				}, options.serverOptions...),
+				ProverOptions: proverOptions,
+				OnStart:       options.onStart,
+				OnStop:        options.onStop,
+			})
 		},
 	}
+	addExpectedCircuitProfileFlag(cmd)
+	addProfileFlag(cmd)
 	cmd.Flags().String(flagR1CS, "r1cs.bin", "Path to the compiled R1CS circuit.")
 	cmd.Flags().String(flagPK, "pk.bin", "Path to the proving key.")
 	cmd.Flags().String(flagVK, "vk.bin", "Path to the verifying key.")
+	cmd.Flags().String(flagPreviousR1CS, "", "Path to the R1CS of the previous circuit, served alongside the current one during the rollover window.")
+	cmd.Flags().String(flagPreviousPK, "", "Path to the proving key of the previous circuit.")
+	cmd.Flags().String(flagPreviousVK, "", "Path to the verifying key of the previous circuit.")
+	cmd.Flags().Duration(flagRolloverWindow, 24*time.Hour, "How long the previous circuit is served after startup before its keys are unloaded.")
+	cmd.Flags().String(flagNetwork, "tcp", "Network of the listeners: tcp4, tcp6 or tcp. With tcp, an unspecified address such as [::]:9999 listens on both IPv4 and IPv6 when the host supports it, while tcp6 only accepts IPv6. Pass several uris to bind each address family explicitly.")
+	cmd.Flags().String(flagCrossCheckURI, "", "Prover, typically verify-only, a sample of the generated proofs is sent to for independent verification. Mismatches are logged.")
+	cmd.Flags().Float64(flagCrossCheckRate, 0.1, "Fraction of the generated proofs that are cross checked.")
+	cmd.Flags().Bool(flagCrossCheckTLS, false, "Whether the cross check verifier expects TLS.")
 	cmd.Flags().Int(flagMaxConn, 1, "Maximum number of concurrent connection.")
+	cmd.Flags().Int(flagMaxQueuedJobs, 0, "Maximum number of jobs waiting for a proving slot, 0 rejects the jobs submitted while all the slots are taken.")
+	cmd.Flags().Int(flagTenantMaxJobs, 0, "Maximum number of running and queued jobs of each authenticated client, 0 is unlimited.")
+	cmd.Flags().Duration(flagResultMaxAge, 0, "Time after which the results of the finished jobs are evicted, 0 keeps them until superseded.")
+	cmd.Flags().Duration(flagFreshnessWindow, 0, "Reject the requests whose header is timestamped further than this from the local clock, 0 accepts any header.")
+	cmd.Flags().Bool(flagMonotonicHeights, false, "Reject the requests of a client for a height below one it already requested for the same chain.")
+	cmd.Flags().StringSlice(flagNTPServer, nil, "NTP servers, as host or host:port, the local clock is checked against. The skew is reported through the galoisd_clock metrics and the galoisd.clock health service.")
+	cmd.Flags().Duration(flagMaxClockSkew, time.Second, "Offset of the local clock from the NTP servers above which it is reported unhealthy.")
+	cmd.Flags().Duration(flagClockCheckInterval, 5*time.Minute, "How often the local clock is checked against the NTP servers.")
+	cmd.Flags().Duration(flagProofAuditInterval, 0, "How often a sample of the cached and persisted proofs is verified again to detect their corruption, disabled if 0.")
+	cmd.Flags().Int(flagProofAuditSample, 16, "Number of cached and of persisted proofs verified per audit.")
+	cmd.Flags().Int(flagMaxRequestBytes, 0, "Size above which the requests carrying a commit are rejected before being decoded, derived from the maximum number of validators of the circuit if 0.")
+	cmd.Flags().Int64(flagResultHeightWindow, -1, "Number of heights a proof is kept for once a later height of the same chain is proven, 0 evicts it as soon as it is superseded and -1 never does.")
+	cmd.Flags().String(flagQueueWeights, "", "Path to a JSON file of the client weights used to share the queue, e.g. {\"default\": 1, \"clients\": {\"relayer-a\": 4}}. Clients are identified by host unless authenticated.")
+	cmd.Flags().String(flagCircuitReservations, "", "Path to a JSON file of the proving slots and memory dedicated to each circuit, e.g. {\"memory\": 68719476736, \"circuits\": {\"current\": {\"slots\": 2, \"job_memory\": 25769803776}, \"previous\": {\"slots\": 1, \"pinned\": true}}}. Circuits are given by hex ID, current or previous.")
+	cmd.Flags().Float64(flagAcceptRate, 0, "Maximum number of connections admitted per second, 0 disables accept rate shaping.")
+	cmd.Flags().Int(flagAcceptBurst, 8, "Number of connections that can be admitted at once above the accept rate.")
+	cmd.Flags().Duration(flagAcceptTimeout, 0, "How long an incoming connection can wait to be admitted before being closed, 0 lets connections wait until admitted.")
+	cmd.Flags().Int(flagAcceptBacklog, listener.DefaultBacklog, "Number of accepted connections waiting to be admitted, further connections wait in the kernel accept queue.")
+	cmd.Flags().Duration(flagSlowClientTimeout, 30*time.Second, "How long a client can send a request slower than the minimum rate before being reported as slow, disabled if 0.")
+	cmd.Flags().Float64(flagSlowClientMinRate, 1024, "Bytes per second a client must send its request at once started.")
+	cmd.Flags().Bool(flagSlowClientDisconnect, false, "Close the connections of the slow clients, freeing their slot, instead of only logging them.")
+	cmd.Flags().String(flagMetricsAddr, "", "Address of the Prometheus metrics endpoint, e.g. localhost:9090. Disabled if empty.")
+	cmd.Flags().String(flagAdminAddr, "127.0.0.1:9998", "Address of the admin service (snapshots, jobs, maintenance, keys, log level), as host:port or unix:path, served along with the public API. Keep it on loopback or a unix socket unless --authz-policy restricts it. Disabled if empty.")
+	cmd.Flags().String(flagTLSCert, "", "Path to the PEM encoded TLS certificate, TLS is disabled if empty.")
+	cmd.Flags().String(flagTLSKey, "", "Path to the PEM encoded TLS private key.")
+	cmd.Flags().Duration(flagTLSReloadInterval, time.Minute, "How often the TLS certificate is checked for changes, 0 only reloads it on SIGHUP.")
 	cmd.Flags().Int(flagLogLevel, int(zerolog.InfoLevel), "Log level see https://github.com/rs/zerolog/blob/c78e50e2da70f4ae63e1b65222c3acf12e9ba699/README.md#leveled-logging")
+	cmd.Flags().Int(flagGCPercent, 100, "Garbage collection target percentage (GOGC), -1 disables the collector. Higher values reduce GC pauses during proving at the cost of memory.")
+	cmd.Flags().String(flagSnapshotPath, "", "Path of the daemon state snapshot. If set, the snapshot is restored on startup and saved on shutdown.")
+	cmd.Flags().String(flagProofOutputDir, "", "Directory where every generated proof and its public inputs are written, named after the request hash.")
+	cmd.Flags().String(flagInputsDir, "inputs", "Directory of the storage backend where the inputs uploaded through UploadInputs are kept.")
+	cmd.Flags().String(flagStorage, "file://", "Storage backend of the circuit, keys, proofs and snapshot, e.g. file:///var/lib/galoisd or mem://. Paths are relative to the root of the backend.")
+	cmd.Flags().String(flagEntropySource, "", "Device mixed into the runtime randomness, e.g. /dev/hwrng. It is continuously health tested and the prover fails closed if it degrades.")
+	cmd.Flags().Bool(flagSandbox, false, "Once the keys are loaded and the listeners bound, drop privileges, deny the syscalls the prover doesn't need and restrict filesystem access to the data dirs and TLS certificate (Linux only).")
+	cmd.Flags().String(flagAuthzPolicy, "", "Path to the JSON authorization policy, mapping the sha256 of bearer tokens to principals and the methods they are allowed to call. Every call is authorized against it if set.")
+	cmd.Flags().Duration(flagAuthzReloadInterval, time.Minute, "How often the authorization policy is checked for changes, 0 only reloads it on SIGHUP.")
+	cmd.Flags().String(flagSandboxUser, "", "User (name or uid) the daemon switches to when sandboxed, e.g. nobody. The current user is kept if empty.")
+	cmd.Flags().StringSlice(flagSandboxDataDir, []string{"."}, "Directories that remain readable and writable when sandboxed, the snapshot and proof output dir must be under one of them.")
+	cmd.Flags().Int64(flagMemoryLimit, 0, "Soft memory limit of the process in MiB (GOMEMLIMIT), 0 disables it. Pair it with a high gc-percent to only collect when approaching the limit. It doesn't bound the peak memory of the proofs, see --circuit-reservations.")
 	return cmd
 }
+
+func sandboxConfig(sandboxUser string, dataDirs []string, readOnlyFiles ...string) (sandbox.Config, error) {
+	cfg := sandbox.Config{
+		ReadWrite: dataDirs,
+	}
+	if sandboxUser != "" {
+		u, err := user.Lookup(sandboxUser)
+		if err != nil {
+			u, err = user.LookupId(sandboxUser)
+			if err != nil {
+				return cfg, fmt.Errorf("Could not find sandbox user %s", err)
+			}
+		}
+		uid, err := strconv.Atoi(u.Uid)
+		if err != nil {
+			return cfg, fmt.Errorf("Could not parse uid %s", err)
+		}
+		gid, err := strconv.Atoi(u.Gid)
+		if err != nil {
+			return cfg, fmt.Errorf("Could not parse gid %s", err)
+		}
+		cfg.UID = &uid
+		cfg.GID = &gid
+	}
+	// The certificate, policy and keys are usually rotated by replacing the
+	// file, the rule must cover its directory rather than the current inode.
+	for _, file := range readOnlyFiles {
+		if file != "" {
+			cfg.ReadOnly = append(cfg.ReadOnly, filepath.Dir(file))
+		}
+	}
+	// Written and read by GetAttestation inside an enclave.
+	if _, err := os.Stat(provergrpc.AttestationDir); err == nil {
+		cfg.ReadWrite = append(cfg.ReadWrite, provergrpc.AttestationDir)
+	}
+	// Read by the metrics process collector.
+	if _, err := os.Stat("/proc/self"); err == nil {
+		cfg.ReadOnly = append(cfg.ReadOnly, "/proc/self")
+	}
+	return cfg, nil
+}
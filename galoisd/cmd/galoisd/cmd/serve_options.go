@@ -0,0 +1,66 @@
+package cmd
+
+import (
+	provergrpc "galois/grpc"
+
+	"google.golang.org/grpc"
+)
+
+// ServeOption allows downstream deployments to extend the serve command
+// without forking it (custom authentication, billing, tracing...).
+type ServeOption func(*serveOptions)
+
+type serveOptions struct {
+	unaryInterceptors  []grpc.UnaryServerInterceptor
+	streamInterceptors []grpc.StreamServerInterceptor
+	serverOptions      []grpc.ServerOption
+	proverOptions      []provergrpc.Option
+	onStart            []func(*grpc.Server) error
+	onStop             []func()
+}
+
+// WithUnaryInterceptor chains a unary interceptor in front of the prover handlers.
+// Interceptors are executed in registration order.
+func WithUnaryInterceptor(interceptor grpc.UnaryServerInterceptor) ServeOption {
+	return func(o *serveOptions) {
+		o.unaryInterceptors = append(o.unaryInterceptors, interceptor)
+	}
+}
+
+// WithStreamInterceptor chains a stream interceptor in front of the prover handlers.
+// Interceptors are executed in registration order.
+func WithStreamInterceptor(interceptor grpc.StreamServerInterceptor) ServeOption {
+	return func(o *serveOptions) {
+		o.streamInterceptors = append(o.streamInterceptors, interceptor)
+	}
+}
+
+// WithServerOptions forwards raw options to the underlying gRPC server.
+func WithServerOptions(opts ...grpc.ServerOption) ServeOption {
+	return func(o *serveOptions) {
+		o.serverOptions = append(o.serverOptions, opts...)
+	}
+}
+
+// WithProverOptions forwards options to the prover server.
+func WithProverOptions(opts ...provergrpc.Option) ServeOption {
+	return func(o *serveOptions) {
+		o.proverOptions = append(o.proverOptions, opts...)
+	}
+}
+
+// WithStartHook registers a hook called once the services are registered, right
+// before the server starts accepting connections. Additional services can be
+// registered on the provided server. Returning an error aborts the startup.
+func WithStartHook(hook func(*grpc.Server) error) ServeOption {
+	return func(o *serveOptions) {
+		o.onStart = append(o.onStart, hook)
+	}
+}
+
+// WithStopHook registers a hook called after the server stopped serving.
+func WithStopHook(hook func()) ServeOption {
+	return func(o *serveOptions) {
+		o.onStop = append(o.onStop, hook)
+	}
+}
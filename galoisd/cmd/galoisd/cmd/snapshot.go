@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	provergrpc "galois/grpc"
+	provergrpcapi "galois/grpc/api/v3"
+	"galois/pkg/storage"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+)
+
+const maxSnapshotSize = 1 << 30
+
+func SnapshotCmd() *cobra.Command {
+	var cmd = &cobra.Command{
+		Short: "Save or restore the state (pending and completed jobs) of a running prover daemon",
+		Use:   "snapshot",
+	}
+	cmd.AddCommand(SnapshotSaveCmd(), SnapshotRestoreCmd())
+	return cmd
+}
+
+func SnapshotSaveCmd() *cobra.Command {
+	var cmd = &cobra.Command{
+		Short: "Dump the state of a running prover daemon to a file",
+		Use:   "save [uri] [path]",
+		Args:  cobra.ExactArgs(2),
+		RunE: MakeAdminCobra(func(ctx context.Context, client provergrpcapi.UnionProverAdminAPIClient, cmd *cobra.Command, args []string) error {
+			res, err := client.SaveSnapshot(ctx, &provergrpcapi.SaveSnapshotRequest{}, grpc.MaxCallRecvMsgSize(maxSnapshotSize))
+			if err != nil {
+				return err
+			}
+			err = provergrpc.WriteSnapshot(ctx, storage.NewFilesystem(""), args[1], res.Snapshot)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("Saved %d entries\n", len(res.Snapshot.Entries))
+			return nil
+		}),
+	}
+	cmd.Flags().String(flagTLS, "", "Whether the gRPC endpoint expect TLS.")
+	cmd.Flags().Bool(flagInsecureToken, false, "Send the GALOISD_TOKEN bearer token without TLS, e.g. to a proxy terminating TLS on the same host.")
+	return cmd
+}
+
+func SnapshotRestoreCmd() *cobra.Command {
+	var cmd = &cobra.Command{
+		Short: "Load a previously saved state into a running prover daemon",
+		Use:   "restore [uri] [path]",
+		Args:  cobra.ExactArgs(2),
+		RunE: MakeAdminCobra(func(ctx context.Context, client provergrpcapi.UnionProverAdminAPIClient, cmd *cobra.Command, args []string) error {
+			snapshot, err := provergrpc.ReadSnapshot(ctx, storage.NewFilesystem(""), args[1])
+			if err != nil {
+				return err
+			}
+			res, err := client.RestoreSnapshot(ctx, &provergrpcapi.RestoreSnapshotRequest{
+				Snapshot: snapshot,
+			}, grpc.MaxCallSendMsgSize(maxSnapshotSize))
+			if err != nil {
+				return err
+			}
+			fmt.Printf("Restored %d entries, resumed %d jobs\n", res.Restored, res.Resumed)
+			return nil
+		}),
+	}
+	cmd.Flags().String(flagTLS, "", "Whether the gRPC endpoint expect TLS.")
+	cmd.Flags().Bool(flagInsecureToken, false, "Send the GALOISD_TOKEN bearer token without TLS, e.g. to a proxy terminating TLS on the same host.")
+	return cmd
+}
@@ -1,31 +1,24 @@
 package cmd
 
 import (
-	"bufio"
+	"context"
+	"fmt"
+	"galois/pkg/storage"
 	"io"
-	"os"
 )
 
+// Local files are written atomically and checked to be complete when read, see
+// the filesystem storage backend.
+var localFiles = storage.NewFilesystem("")
+
 func saveTo(file string, x io.WriterTo) error {
-	f, err := os.Create(file)
-	if err != nil {
-		return err
-	}
-	defer f.Close()
-	w := bufio.NewWriter(f)
-	_, err = x.WriteTo(w)
-	if err != nil {
-		return err
-	}
-	return w.Flush()
+	_, err := storage.SaveTo(context.Background(), localFiles, file, x)
+	return err
 }
 
 func readFrom(file string, obj io.ReaderFrom) error {
-	f, err := os.OpenFile(file, os.O_RDONLY, os.ModePerm)
-	if err != nil {
-		return err
+	if _, err := storage.ReadFrom(context.Background(), localFiles, file, obj); err != nil {
+		return fmt.Errorf("Could not read %s: %s", file, err)
 	}
-	defer f.Close()
-	_, err = obj.ReadFrom(f)
-	return err
+	return nil
 }
@@ -0,0 +1,74 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	provergrpc "galois/grpc"
+	"galois/pkg/verify"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+// Verify a proof locally, without any prover daemon. The verifying key is read
+// from the vk-path, or from the binary itself when built with the embed_vk tag.
+func VerifyCmd() *cobra.Command {
+	var cmd = &cobra.Command{
+		Short: "Verify a proof written by the prover in its proof output directory against the request it answers, '-' reading it from stdin",
+		Use:   "verify [proof_path]",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			vkPath, err := cmd.Flags().GetString(flagVK)
+			if err != nil {
+				return err
+			}
+			vk, err := provergrpc.LoadVerifyingKey(vkPath)
+			if err != nil {
+				return err
+			}
+			var bz []byte
+			if args[0] == "-" {
+				bz, err = io.ReadAll(os.Stdin)
+			} else {
+				bz, err = os.ReadFile(args[0])
+			}
+			if err != nil {
+				return err
+			}
+			var proof provergrpc.PersistedProof
+			if err := json.Unmarshal(bz, &proof); err != nil {
+				return fmt.Errorf("Could not decode proof %s", err)
+			}
+			req, res := proof.Request, proof.Response
+			if res == nil || res.Proof == nil {
+				return fmt.Errorf("Missing proof")
+			}
+			if req == nil || req.UntrustedHeader == nil || req.TrustedCommit == nil {
+				return fmt.Errorf("Missing request")
+			}
+			// The hash is recomputed such that the proof is checked against
+			// the request it answers, not whatever hash was stored with it.
+			_, trustedValidatorsRoot, err := provergrpc.MarshalValidators(req.TrustedCommit.Validators)
+			if err != nil {
+				return fmt.Errorf("Could not marshal trusted validators %s", err)
+			}
+			if !bytes.Equal(trustedValidatorsRoot, res.TrustedValidatorSetRoot) {
+				return fmt.Errorf("Trusted validator set root %x doesn't match the request, expected %x", res.TrustedValidatorSetRoot, trustedValidatorsRoot)
+			}
+			inputsHash := provergrpc.InputsHash(req.GetVote().GetChainID(), req.UntrustedHeader, trustedValidatorsRoot)
+			if !bytes.Equal(inputsHash, proof.InputsHash) {
+				return fmt.Errorf("Inputs hash %x doesn't match the request, expected %x", proof.InputsHash, inputsHash)
+			}
+			err = verify.VerifyInputsHash(vk, res.Proof.CompressedContent, inputsHash)
+			if err != nil {
+				return fmt.Errorf("Invalid proof for inputs hash %x: %s", inputsHash, err)
+			}
+			fmt.Printf("Valid proof for inputs hash %x\n", inputsHash)
+			return nil
+		},
+	}
+	cmd.Flags().String(flagVK, "vk.bin", "Path to the verifying key, the embedded one being used if the file doesn't exist.")
+	return cmd
+}
@@ -5,6 +5,8 @@ package main
 
 import (
 	"galois/cmd/galoisd/cmd"
+	"os"
+
 	"github.com/spf13/cobra"
 )
 
@@ -14,8 +16,20 @@ func main() {
 	rootCmd.AddCommand(cmd.GenContract())
 	rootCmd.AddCommand(cmd.ExampleProveCmd())
 	rootCmd.AddCommand(cmd.ExampleVerifyCmd())
+	rootCmd.AddCommand(cmd.VerifyCmd())
 	rootCmd.AddCommand(cmd.QueryStats())
 	rootCmd.AddCommand(cmd.QueryStatsHealth())
+	rootCmd.AddCommand(cmd.QueryLoad())
+	rootCmd.AddCommand(cmd.QueryInfo())
+	rootCmd.AddCommand(cmd.SnapshotCmd())
+	rootCmd.AddCommand(cmd.JobsCmd())
+	rootCmd.AddCommand(cmd.MaintenanceCmd())
+	rootCmd.AddCommand(cmd.CtlCmd())
+	rootCmd.AddCommand(cmd.TestE2ECmd())
+	rootCmd.AddCommand(cmd.LoadtestCmd())
+	rootCmd.AddCommand(cmd.DifftestCmd())
+	rootCmd.AddCommand(cmd.GenDevKeysCmd())
+	rootCmd.AddCommand(cmd.GenVectorsCmd())
 	rootCmd.AddCommand(
 		cmd.Phase1InitCmd(),
 		cmd.Phase2InitCmd(),
@@ -23,5 +37,7 @@ func main() {
 		cmd.Phase2VerifyCmd(),
 		cmd.Phase2ExtractCmd(),
 	)
-	rootCmd.Execute()
+	if err := rootCmd.Execute(); err != nil {
+		os.Exit(1)
+	}
 }
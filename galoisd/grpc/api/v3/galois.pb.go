@@ -10,6 +10,8 @@ import (
 	v1 "github.com/cometbft/cometbft/api/cometbft/types/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -21,6 +23,211 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type InputsCommitmentScheme int32
+
+const (
+	InputsCommitmentScheme_INPUTS_COMMITMENT_SCHEME_UNSPECIFIED InputsCommitmentScheme = 0
+	InputsCommitmentScheme_INPUTS_COMMITMENT_SCHEME_KECCAK256   InputsCommitmentScheme = 1
+	InputsCommitmentScheme_INPUTS_COMMITMENT_SCHEME_SHA256      InputsCommitmentScheme = 2
+)
+
+// Enum value maps for InputsCommitmentScheme.
+var (
+	InputsCommitmentScheme_name = map[int32]string{
+		0: "INPUTS_COMMITMENT_SCHEME_UNSPECIFIED",
+		1: "INPUTS_COMMITMENT_SCHEME_KECCAK256",
+		2: "INPUTS_COMMITMENT_SCHEME_SHA256",
+	}
+	InputsCommitmentScheme_value = map[string]int32{
+		"INPUTS_COMMITMENT_SCHEME_UNSPECIFIED": 0,
+		"INPUTS_COMMITMENT_SCHEME_KECCAK256":   1,
+		"INPUTS_COMMITMENT_SCHEME_SHA256":      2,
+	}
+)
+
+func (x InputsCommitmentScheme) Enum() *InputsCommitmentScheme {
+	p := new(InputsCommitmentScheme)
+	*p = x
+	return p
+}
+
+func (x InputsCommitmentScheme) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InputsCommitmentScheme) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v3_galois_proto_enumTypes[0].Descriptor()
+}
+
+func (InputsCommitmentScheme) Type() protoreflect.EnumType {
+	return &file_api_v3_galois_proto_enumTypes[0]
+}
+
+func (x InputsCommitmentScheme) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InputsCommitmentScheme.Descriptor instead.
+func (InputsCommitmentScheme) EnumDescriptor() ([]byte, []int) {
+	return file_api_v3_galois_proto_rawDescGZIP(), []int{0}
+}
+
+type JobState int32
+
+const (
+	JobState_JOB_STATE_UNSPECIFIED JobState = 0
+	JobState_JOB_STATE_QUEUED      JobState = 1
+	JobState_JOB_STATE_RUNNING     JobState = 2
+	JobState_JOB_STATE_DONE        JobState = 3
+	JobState_JOB_STATE_FAILED      JobState = 4
+	JobState_JOB_STATE_CANCELLED   JobState = 5
+)
+
+// Enum value maps for JobState.
+var (
+	JobState_name = map[int32]string{
+		0: "JOB_STATE_UNSPECIFIED",
+		1: "JOB_STATE_QUEUED",
+		2: "JOB_STATE_RUNNING",
+		3: "JOB_STATE_DONE",
+		4: "JOB_STATE_FAILED",
+		5: "JOB_STATE_CANCELLED",
+	}
+	JobState_value = map[string]int32{
+		"JOB_STATE_UNSPECIFIED": 0,
+		"JOB_STATE_QUEUED":      1,
+		"JOB_STATE_RUNNING":     2,
+		"JOB_STATE_DONE":        3,
+		"JOB_STATE_FAILED":      4,
+		"JOB_STATE_CANCELLED":   5,
+	}
+)
+
+func (x JobState) Enum() *JobState {
+	p := new(JobState)
+	*p = x
+	return p
+}
+
+func (x JobState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (JobState) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v3_galois_proto_enumTypes[1].Descriptor()
+}
+
+func (JobState) Type() protoreflect.EnumType {
+	return &file_api_v3_galois_proto_enumTypes[1]
+}
+
+func (x JobState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use JobState.Descriptor instead.
+func (JobState) EnumDescriptor() ([]byte, []int) {
+	return file_api_v3_galois_proto_rawDescGZIP(), []int{1}
+}
+
+type ServingState int32
+
+const (
+	ServingState_SERVING_STATE_UNSPECIFIED ServingState = 0
+	ServingState_SERVING_STATE_SERVING     ServingState = 1
+	ServingState_SERVING_STATE_DRAINING    ServingState = 2
+)
+
+// Enum value maps for ServingState.
+var (
+	ServingState_name = map[int32]string{
+		0: "SERVING_STATE_UNSPECIFIED",
+		1: "SERVING_STATE_SERVING",
+		2: "SERVING_STATE_DRAINING",
+	}
+	ServingState_value = map[string]int32{
+		"SERVING_STATE_UNSPECIFIED": 0,
+		"SERVING_STATE_SERVING":     1,
+		"SERVING_STATE_DRAINING":    2,
+	}
+)
+
+func (x ServingState) Enum() *ServingState {
+	p := new(ServingState)
+	*p = x
+	return p
+}
+
+func (x ServingState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ServingState) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v3_galois_proto_enumTypes[2].Descriptor()
+}
+
+func (ServingState) Type() protoreflect.EnumType {
+	return &file_api_v3_galois_proto_enumTypes[2]
+}
+
+func (x ServingState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ServingState.Descriptor instead.
+func (ServingState) EnumDescriptor() ([]byte, []int) {
+	return file_api_v3_galois_proto_rawDescGZIP(), []int{2}
+}
+
+type KeysState int32
+
+const (
+	KeysState_KEYS_STATE_UNSPECIFIED KeysState = 0
+	KeysState_KEYS_STATE_COLD        KeysState = 1
+	KeysState_KEYS_STATE_WARM        KeysState = 2
+)
+
+// Enum value maps for KeysState.
+var (
+	KeysState_name = map[int32]string{
+		0: "KEYS_STATE_UNSPECIFIED",
+		1: "KEYS_STATE_COLD",
+		2: "KEYS_STATE_WARM",
+	}
+	KeysState_value = map[string]int32{
+		"KEYS_STATE_UNSPECIFIED": 0,
+		"KEYS_STATE_COLD":        1,
+		"KEYS_STATE_WARM":        2,
+	}
+)
+
+func (x KeysState) Enum() *KeysState {
+	p := new(KeysState)
+	*p = x
+	return p
+}
+
+func (x KeysState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (KeysState) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v3_galois_proto_enumTypes[3].Descriptor()
+}
+
+func (KeysState) Type() protoreflect.EnumType {
+	return &file_api_v3_galois_proto_enumTypes[3]
+}
+
+func (x KeysState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use KeysState.Descriptor instead.
+func (KeysState) EnumDescriptor() ([]byte, []int) {
+	return file_api_v3_galois_proto_rawDescGZIP(), []int{3}
+}
+
 type FrElement struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -207,10 +414,12 @@ type ProveRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Vote            *v1.CanonicalVote   `protobuf:"bytes,1,opt,name=vote,proto3" json:"vote,omitempty"`
-	UntrustedHeader *v1.Header          `protobuf:"bytes,2,opt,name=untrusted_header,json=untrustedHeader,proto3" json:"untrusted_header,omitempty"`
-	TrustedCommit   *ValidatorSetCommit `protobuf:"bytes,3,opt,name=trusted_commit,json=trustedCommit,proto3" json:"trusted_commit,omitempty"`
-	UntrustedCommit *ValidatorSetCommit `protobuf:"bytes,4,opt,name=untrusted_commit,json=untrustedCommit,proto3" json:"untrusted_commit,omitempty"`
+	Vote                   *v1.CanonicalVote      `protobuf:"bytes,1,opt,name=vote,proto3" json:"vote,omitempty"`
+	UntrustedHeader        *v1.Header             `protobuf:"bytes,2,opt,name=untrusted_header,json=untrustedHeader,proto3" json:"untrusted_header,omitempty"`
+	TrustedCommit          *ValidatorSetCommit    `protobuf:"bytes,3,opt,name=trusted_commit,json=trustedCommit,proto3" json:"trusted_commit,omitempty"`
+	UntrustedCommit        *ValidatorSetCommit    `protobuf:"bytes,4,opt,name=untrusted_commit,json=untrustedCommit,proto3" json:"untrusted_commit,omitempty"`
+	CircuitId              []byte                 `protobuf:"bytes,5,opt,name=circuit_id,json=circuitId,proto3" json:"circuit_id,omitempty"`
+	InputsCommitmentScheme InputsCommitmentScheme `protobuf:"varint,6,opt,name=inputs_commitment_scheme,json=inputsCommitmentScheme,proto3,enum=union.galois.api.v3.InputsCommitmentScheme" json:"inputs_commitment_scheme,omitempty"`
 }
 
 func (x *ProveRequest) Reset() {
@@ -273,6 +482,75 @@ func (x *ProveRequest) GetUntrustedCommit() *ValidatorSetCommit {
 	return nil
 }
 
+func (x *ProveRequest) GetCircuitId() []byte {
+	if x != nil {
+		return x.CircuitId
+	}
+	return nil
+}
+
+func (x *ProveRequest) GetInputsCommitmentScheme() InputsCommitmentScheme {
+	if x != nil {
+		return x.InputsCommitmentScheme
+	}
+	return InputsCommitmentScheme_INPUTS_COMMITMENT_SCHEME_UNSPECIFIED
+}
+
+type InputsCommitment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Scheme InputsCommitmentScheme `protobuf:"varint,1,opt,name=scheme,proto3,enum=union.galois.api.v3.InputsCommitmentScheme" json:"scheme,omitempty"`
+	Digest []byte                 `protobuf:"bytes,2,opt,name=digest,proto3" json:"digest,omitempty"`
+}
+
+func (x *InputsCommitment) Reset() {
+	*x = InputsCommitment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v3_galois_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InputsCommitment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InputsCommitment) ProtoMessage() {}
+
+func (x *InputsCommitment) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v3_galois_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InputsCommitment.ProtoReflect.Descriptor instead.
+func (*InputsCommitment) Descriptor() ([]byte, []int) {
+	return file_api_v3_galois_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *InputsCommitment) GetScheme() InputsCommitmentScheme {
+	if x != nil {
+		return x.Scheme
+	}
+	return InputsCommitmentScheme_INPUTS_COMMITMENT_SCHEME_UNSPECIFIED
+}
+
+func (x *InputsCommitment) GetDigest() []byte {
+	if x != nil {
+		return x.Digest
+	}
+	return nil
+}
+
 type ProveResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -280,12 +558,13 @@ type ProveResponse struct {
 
 	Proof                   *ZeroKnowledgeProof `protobuf:"bytes,1,opt,name=proof,proto3" json:"proof,omitempty"`
 	TrustedValidatorSetRoot []byte              `protobuf:"bytes,2,opt,name=trusted_validator_set_root,json=trustedValidatorSetRoot,proto3" json:"trusted_validator_set_root,omitempty"`
+	InputsCommitment        *InputsCommitment   `protobuf:"bytes,3,opt,name=inputs_commitment,json=inputsCommitment,proto3" json:"inputs_commitment,omitempty"`
 }
 
 func (x *ProveResponse) Reset() {
 	*x = ProveResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v3_galois_proto_msgTypes[4]
+		mi := &file_api_v3_galois_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -298,7 +577,7 @@ func (x *ProveResponse) String() string {
 func (*ProveResponse) ProtoMessage() {}
 
 func (x *ProveResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v3_galois_proto_msgTypes[4]
+	mi := &file_api_v3_galois_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -311,7 +590,7 @@ func (x *ProveResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProveResponse.ProtoReflect.Descriptor instead.
 func (*ProveResponse) Descriptor() ([]byte, []int) {
-	return file_api_v3_galois_proto_rawDescGZIP(), []int{4}
+	return file_api_v3_galois_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ProveResponse) GetProof() *ZeroKnowledgeProof {
@@ -328,6 +607,13 @@ func (x *ProveResponse) GetTrustedValidatorSetRoot() []byte {
 	return nil
 }
 
+func (x *ProveResponse) GetInputsCommitment() *InputsCommitment {
+	if x != nil {
+		return x.InputsCommitment
+	}
+	return nil
+}
+
 type VerifyRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -335,12 +621,13 @@ type VerifyRequest struct {
 
 	Proof      *ZeroKnowledgeProof `protobuf:"bytes,1,opt,name=proof,proto3" json:"proof,omitempty"`
 	InputsHash []byte              `protobuf:"bytes,2,opt,name=inputs_hash,json=inputsHash,proto3" json:"inputs_hash,omitempty"`
+	CircuitId  []byte              `protobuf:"bytes,3,opt,name=circuit_id,json=circuitId,proto3" json:"circuit_id,omitempty"`
 }
 
 func (x *VerifyRequest) Reset() {
 	*x = VerifyRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v3_galois_proto_msgTypes[5]
+		mi := &file_api_v3_galois_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -353,7 +640,7 @@ func (x *VerifyRequest) String() string {
 func (*VerifyRequest) ProtoMessage() {}
 
 func (x *VerifyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v3_galois_proto_msgTypes[5]
+	mi := &file_api_v3_galois_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -366,7 +653,7 @@ func (x *VerifyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VerifyRequest.ProtoReflect.Descriptor instead.
 func (*VerifyRequest) Descriptor() ([]byte, []int) {
-	return file_api_v3_galois_proto_rawDescGZIP(), []int{5}
+	return file_api_v3_galois_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *VerifyRequest) GetProof() *ZeroKnowledgeProof {
@@ -383,6 +670,13 @@ func (x *VerifyRequest) GetInputsHash() []byte {
 	return nil
 }
 
+func (x *VerifyRequest) GetCircuitId() []byte {
+	if x != nil {
+		return x.CircuitId
+	}
+	return nil
+}
+
 type VerifyResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -394,7 +688,7 @@ type VerifyResponse struct {
 func (x *VerifyResponse) Reset() {
 	*x = VerifyResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v3_galois_proto_msgTypes[6]
+		mi := &file_api_v3_galois_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -407,7 +701,7 @@ func (x *VerifyResponse) String() string {
 func (*VerifyResponse) ProtoMessage() {}
 
 func (x *VerifyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v3_galois_proto_msgTypes[6]
+	mi := &file_api_v3_galois_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -420,7 +714,7 @@ func (x *VerifyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VerifyResponse.ProtoReflect.Descriptor instead.
 func (*VerifyResponse) Descriptor() ([]byte, []int) {
-	return file_api_v3_galois_proto_rawDescGZIP(), []int{6}
+	return file_api_v3_galois_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *VerifyResponse) GetValid() bool {
@@ -439,7 +733,7 @@ type GenerateContractRequest struct {
 func (x *GenerateContractRequest) Reset() {
 	*x = GenerateContractRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v3_galois_proto_msgTypes[7]
+		mi := &file_api_v3_galois_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -452,7 +746,7 @@ func (x *GenerateContractRequest) String() string {
 func (*GenerateContractRequest) ProtoMessage() {}
 
 func (x *GenerateContractRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v3_galois_proto_msgTypes[7]
+	mi := &file_api_v3_galois_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -465,7 +759,7 @@ func (x *GenerateContractRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenerateContractRequest.ProtoReflect.Descriptor instead.
 func (*GenerateContractRequest) Descriptor() ([]byte, []int) {
-	return file_api_v3_galois_proto_rawDescGZIP(), []int{7}
+	return file_api_v3_galois_proto_rawDescGZIP(), []int{8}
 }
 
 type GenerateContractResponse struct {
@@ -479,7 +773,7 @@ type GenerateContractResponse struct {
 func (x *GenerateContractResponse) Reset() {
 	*x = GenerateContractResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v3_galois_proto_msgTypes[8]
+		mi := &file_api_v3_galois_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -492,7 +786,7 @@ func (x *GenerateContractResponse) String() string {
 func (*GenerateContractResponse) ProtoMessage() {}
 
 func (x *GenerateContractResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v3_galois_proto_msgTypes[8]
+	mi := &file_api_v3_galois_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -505,7 +799,7 @@ func (x *GenerateContractResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenerateContractResponse.ProtoReflect.Descriptor instead.
 func (*GenerateContractResponse) Descriptor() ([]byte, []int) {
-	return file_api_v3_galois_proto_rawDescGZIP(), []int{8}
+	return file_api_v3_galois_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GenerateContractResponse) GetContent() []byte {
@@ -524,7 +818,7 @@ type QueryStatsRequest struct {
 func (x *QueryStatsRequest) Reset() {
 	*x = QueryStatsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v3_galois_proto_msgTypes[9]
+		mi := &file_api_v3_galois_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -537,7 +831,7 @@ func (x *QueryStatsRequest) String() string {
 func (*QueryStatsRequest) ProtoMessage() {}
 
 func (x *QueryStatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v3_galois_proto_msgTypes[9]
+	mi := &file_api_v3_galois_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -550,7 +844,7 @@ func (x *QueryStatsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryStatsRequest.ProtoReflect.Descriptor instead.
 func (*QueryStatsRequest) Descriptor() ([]byte, []int) {
-	return file_api_v3_galois_proto_rawDescGZIP(), []int{9}
+	return file_api_v3_galois_proto_rawDescGZIP(), []int{10}
 }
 
 type VariableStats struct {
@@ -568,7 +862,7 @@ type VariableStats struct {
 func (x *VariableStats) Reset() {
 	*x = VariableStats{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v3_galois_proto_msgTypes[10]
+		mi := &file_api_v3_galois_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -581,7 +875,7 @@ func (x *VariableStats) String() string {
 func (*VariableStats) ProtoMessage() {}
 
 func (x *VariableStats) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v3_galois_proto_msgTypes[10]
+	mi := &file_api_v3_galois_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -594,7 +888,7 @@ func (x *VariableStats) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VariableStats.ProtoReflect.Descriptor instead.
 func (*VariableStats) Descriptor() ([]byte, []int) {
-	return file_api_v3_galois_proto_rawDescGZIP(), []int{10}
+	return file_api_v3_galois_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *VariableStats) GetNbInternalVariables() uint32 {
@@ -644,7 +938,7 @@ type ProvingKeyStats struct {
 func (x *ProvingKeyStats) Reset() {
 	*x = ProvingKeyStats{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v3_galois_proto_msgTypes[11]
+		mi := &file_api_v3_galois_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -657,7 +951,7 @@ func (x *ProvingKeyStats) String() string {
 func (*ProvingKeyStats) ProtoMessage() {}
 
 func (x *ProvingKeyStats) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v3_galois_proto_msgTypes[11]
+	mi := &file_api_v3_galois_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -670,7 +964,7 @@ func (x *ProvingKeyStats) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProvingKeyStats.ProtoReflect.Descriptor instead.
 func (*ProvingKeyStats) Descriptor() ([]byte, []int) {
-	return file_api_v3_galois_proto_rawDescGZIP(), []int{11}
+	return file_api_v3_galois_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ProvingKeyStats) GetNbG1() uint32 {
@@ -700,7 +994,7 @@ type VerifyingKeyStats struct {
 func (x *VerifyingKeyStats) Reset() {
 	*x = VerifyingKeyStats{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v3_galois_proto_msgTypes[12]
+		mi := &file_api_v3_galois_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -713,7 +1007,7 @@ func (x *VerifyingKeyStats) String() string {
 func (*VerifyingKeyStats) ProtoMessage() {}
 
 func (x *VerifyingKeyStats) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v3_galois_proto_msgTypes[12]
+	mi := &file_api_v3_galois_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -726,7 +1020,7 @@ func (x *VerifyingKeyStats) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VerifyingKeyStats.ProtoReflect.Descriptor instead.
 func (*VerifyingKeyStats) Descriptor() ([]byte, []int) {
-	return file_api_v3_galois_proto_rawDescGZIP(), []int{12}
+	return file_api_v3_galois_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *VerifyingKeyStats) GetNbG1() uint32 {
@@ -762,7 +1056,7 @@ type CommitmentStats struct {
 func (x *CommitmentStats) Reset() {
 	*x = CommitmentStats{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v3_galois_proto_msgTypes[13]
+		mi := &file_api_v3_galois_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -775,7 +1069,7 @@ func (x *CommitmentStats) String() string {
 func (*CommitmentStats) ProtoMessage() {}
 
 func (x *CommitmentStats) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v3_galois_proto_msgTypes[13]
+	mi := &file_api_v3_galois_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -788,7 +1082,7 @@ func (x *CommitmentStats) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CommitmentStats.ProtoReflect.Descriptor instead.
 func (*CommitmentStats) Descriptor() ([]byte, []int) {
-	return file_api_v3_galois_proto_rawDescGZIP(), []int{13}
+	return file_api_v3_galois_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *CommitmentStats) GetNbPublicCommitted() uint32 {
@@ -819,7 +1113,7 @@ type QueryStatsResponse struct {
 func (x *QueryStatsResponse) Reset() {
 	*x = QueryStatsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v3_galois_proto_msgTypes[14]
+		mi := &file_api_v3_galois_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -832,7 +1126,7 @@ func (x *QueryStatsResponse) String() string {
 func (*QueryStatsResponse) ProtoMessage() {}
 
 func (x *QueryStatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v3_galois_proto_msgTypes[14]
+	mi := &file_api_v3_galois_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -845,7 +1139,7 @@ func (x *QueryStatsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryStatsResponse.ProtoReflect.Descriptor instead.
 func (*QueryStatsResponse) Descriptor() ([]byte, []int) {
-	return file_api_v3_galois_proto_rawDescGZIP(), []int{14}
+	return file_api_v3_galois_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *QueryStatsResponse) GetVariableStats() *VariableStats {
@@ -876,31 +1170,34 @@ func (x *QueryStatsResponse) GetCommitmentStats() *CommitmentStats {
 	return nil
 }
 
-type PollRequest struct {
+type VersionedProveRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Request *ProveRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	// Types that are assignable to Schema:
+	//
+	//	*VersionedProveRequest_V3
+	Schema isVersionedProveRequest_Schema `protobuf_oneof:"schema"`
 }
 
-func (x *PollRequest) Reset() {
-	*x = PollRequest{}
+func (x *VersionedProveRequest) Reset() {
+	*x = VersionedProveRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v3_galois_proto_msgTypes[15]
+		mi := &file_api_v3_galois_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PollRequest) String() string {
+func (x *VersionedProveRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PollRequest) ProtoMessage() {}
+func (*VersionedProveRequest) ProtoMessage() {}
 
-func (x *PollRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v3_galois_proto_msgTypes[15]
+func (x *VersionedProveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v3_galois_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -911,41 +1208,113 @@ func (x *PollRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PollRequest.ProtoReflect.Descriptor instead.
-func (*PollRequest) Descriptor() ([]byte, []int) {
-	return file_api_v3_galois_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use VersionedProveRequest.ProtoReflect.Descriptor instead.
+func (*VersionedProveRequest) Descriptor() ([]byte, []int) {
+	return file_api_v3_galois_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *PollRequest) GetRequest() *ProveRequest {
-	if x != nil {
-		return x.Request
+func (m *VersionedProveRequest) GetSchema() isVersionedProveRequest_Schema {
+	if m != nil {
+		return m.Schema
 	}
 	return nil
 }
 
-type ProveRequestPending struct {
+func (x *VersionedProveRequest) GetV3() *ProveRequest {
+	if x, ok := x.GetSchema().(*VersionedProveRequest_V3); ok {
+		return x.V3
+	}
+	return nil
+}
+
+type isVersionedProveRequest_Schema interface {
+	isVersionedProveRequest_Schema()
+}
+
+type VersionedProveRequest_V3 struct {
+	V3 *ProveRequest `protobuf:"bytes,1,opt,name=v3,proto3,oneof"`
+}
+
+func (*VersionedProveRequest_V3) isVersionedProveRequest_Schema() {}
+
+type PollRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Request          *ProveRequest          `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	VersionedRequest *VersionedProveRequest `protobuf:"bytes,2,opt,name=versioned_request,json=versionedRequest,proto3" json:"versioned_request,omitempty"`
 }
 
-func (x *ProveRequestPending) Reset() {
-	*x = ProveRequestPending{}
+func (x *PollRequest) Reset() {
+	*x = PollRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v3_galois_proto_msgTypes[16]
+		mi := &file_api_v3_galois_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProveRequestPending) String() string {
+func (x *PollRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProveRequestPending) ProtoMessage() {}
+func (*PollRequest) ProtoMessage() {}
 
-func (x *ProveRequestPending) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v3_galois_proto_msgTypes[16]
+func (x *PollRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v3_galois_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PollRequest.ProtoReflect.Descriptor instead.
+func (*PollRequest) Descriptor() ([]byte, []int) {
+	return file_api_v3_galois_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *PollRequest) GetRequest() *ProveRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *PollRequest) GetVersionedRequest() *VersionedProveRequest {
+	if x != nil {
+		return x.VersionedRequest
+	}
+	return nil
+}
+
+type ProveRequestPending struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ProveRequestPending) Reset() {
+	*x = ProveRequestPending{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v3_galois_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProveRequestPending) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProveRequestPending) ProtoMessage() {}
+
+func (x *ProveRequestPending) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v3_galois_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -958,7 +1327,7 @@ func (x *ProveRequestPending) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProveRequestPending.ProtoReflect.Descriptor instead.
 func (*ProveRequestPending) Descriptor() ([]byte, []int) {
-	return file_api_v3_galois_proto_rawDescGZIP(), []int{16}
+	return file_api_v3_galois_proto_rawDescGZIP(), []int{18}
 }
 
 type ProveRequestFailed struct {
@@ -967,12 +1336,13 @@ type ProveRequestFailed struct {
 	unknownFields protoimpl.UnknownFields
 
 	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Reason  string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
 func (x *ProveRequestFailed) Reset() {
 	*x = ProveRequestFailed{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v3_galois_proto_msgTypes[17]
+		mi := &file_api_v3_galois_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -985,7 +1355,7 @@ func (x *ProveRequestFailed) String() string {
 func (*ProveRequestFailed) ProtoMessage() {}
 
 func (x *ProveRequestFailed) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v3_galois_proto_msgTypes[17]
+	mi := &file_api_v3_galois_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -998,7 +1368,7 @@ func (x *ProveRequestFailed) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProveRequestFailed.ProtoReflect.Descriptor instead.
 func (*ProveRequestFailed) Descriptor() ([]byte, []int) {
-	return file_api_v3_galois_proto_rawDescGZIP(), []int{17}
+	return file_api_v3_galois_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ProveRequestFailed) GetMessage() string {
@@ -1008,6 +1378,13 @@ func (x *ProveRequestFailed) GetMessage() string {
 	return ""
 }
 
+func (x *ProveRequestFailed) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
 type ProveRequestDone struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1019,7 +1396,7 @@ type ProveRequestDone struct {
 func (x *ProveRequestDone) Reset() {
 	*x = ProveRequestDone{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v3_galois_proto_msgTypes[18]
+		mi := &file_api_v3_galois_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1032,399 +1409,4169 @@ func (x *ProveRequestDone) String() string {
 func (*ProveRequestDone) ProtoMessage() {}
 
 func (x *ProveRequestDone) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v3_galois_proto_msgTypes[18]
+	mi := &file_api_v3_galois_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProveRequestDone.ProtoReflect.Descriptor instead.
+func (*ProveRequestDone) Descriptor() ([]byte, []int) {
+	return file_api_v3_galois_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ProveRequestDone) GetResponse() *ProveResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+type PollResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Result:
+	//
+	//	*PollResponse_Pending
+	//	*PollResponse_Failed
+	//	*PollResponse_Done
+	Result isPollResponse_Result `protobuf_oneof:"result"`
+}
+
+func (x *PollResponse) Reset() {
+	*x = PollResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v3_galois_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PollResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PollResponse) ProtoMessage() {}
+
+func (x *PollResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v3_galois_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PollResponse.ProtoReflect.Descriptor instead.
+func (*PollResponse) Descriptor() ([]byte, []int) {
+	return file_api_v3_galois_proto_rawDescGZIP(), []int{21}
+}
+
+func (m *PollResponse) GetResult() isPollResponse_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *PollResponse) GetPending() *ProveRequestPending {
+	if x, ok := x.GetResult().(*PollResponse_Pending); ok {
+		return x.Pending
+	}
+	return nil
+}
+
+func (x *PollResponse) GetFailed() *ProveRequestFailed {
+	if x, ok := x.GetResult().(*PollResponse_Failed); ok {
+		return x.Failed
+	}
+	return nil
+}
+
+func (x *PollResponse) GetDone() *ProveRequestDone {
+	if x, ok := x.GetResult().(*PollResponse_Done); ok {
+		return x.Done
+	}
+	return nil
+}
+
+type isPollResponse_Result interface {
+	isPollResponse_Result()
+}
+
+type PollResponse_Pending struct {
+	Pending *ProveRequestPending `protobuf:"bytes,1,opt,name=pending,proto3,oneof"`
+}
+
+type PollResponse_Failed struct {
+	Failed *ProveRequestFailed `protobuf:"bytes,2,opt,name=failed,proto3,oneof"`
+}
+
+type PollResponse_Done struct {
+	Done *ProveRequestDone `protobuf:"bytes,3,opt,name=done,proto3,oneof"`
+}
+
+func (*PollResponse_Pending) isPollResponse_Result() {}
+
+func (*PollResponse_Failed) isPollResponse_Result() {}
+
+func (*PollResponse_Done) isPollResponse_Result() {}
+
+type GetAttestationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Nonce []byte `protobuf:"bytes,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (x *GetAttestationRequest) Reset() {
+	*x = GetAttestationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v3_galois_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAttestationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttestationRequest) ProtoMessage() {}
+
+func (x *GetAttestationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v3_galois_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttestationRequest.ProtoReflect.Descriptor instead.
+func (*GetAttestationRequest) Descriptor() ([]byte, []int) {
+	return file_api_v3_galois_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetAttestationRequest) GetNonce() []byte {
+	if x != nil {
+		return x.Nonce
+	}
+	return nil
+}
+
+type GetAttestationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CircuitHash      []byte `protobuf:"bytes,1,opt,name=circuit_hash,json=circuitHash,proto3" json:"circuit_hash,omitempty"`
+	VerifyingKeyHash []byte `protobuf:"bytes,2,opt,name=verifying_key_hash,json=verifyingKeyHash,proto3" json:"verifying_key_hash,omitempty"`
+	ReportData       []byte `protobuf:"bytes,3,opt,name=report_data,json=reportData,proto3" json:"report_data,omitempty"`
+	Quote            []byte `protobuf:"bytes,4,opt,name=quote,proto3" json:"quote,omitempty"`
+}
+
+func (x *GetAttestationResponse) Reset() {
+	*x = GetAttestationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v3_galois_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAttestationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttestationResponse) ProtoMessage() {}
+
+func (x *GetAttestationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v3_galois_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ProveRequestDone.ProtoReflect.Descriptor instead.
-func (*ProveRequestDone) Descriptor() ([]byte, []int) {
-	return file_api_v3_galois_proto_rawDescGZIP(), []int{18}
-}
-
-func (x *ProveRequestDone) GetResponse() *ProveResponse {
-	if x != nil {
-		return x.Response
-	}
-	return nil
-}
-
-type PollResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Types that are assignable to Result:
-	//
-	//	*PollResponse_Pending
-	//	*PollResponse_Failed
-	//	*PollResponse_Done
-	Result isPollResponse_Result `protobuf_oneof:"result"`
-}
-
-func (x *PollResponse) Reset() {
-	*x = PollResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v3_galois_proto_msgTypes[19]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *PollResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*PollResponse) ProtoMessage() {}
-
-func (x *PollResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v3_galois_proto_msgTypes[19]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttestationResponse.ProtoReflect.Descriptor instead.
+func (*GetAttestationResponse) Descriptor() ([]byte, []int) {
+	return file_api_v3_galois_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetAttestationResponse) GetCircuitHash() []byte {
+	if x != nil {
+		return x.CircuitHash
+	}
+	return nil
+}
+
+func (x *GetAttestationResponse) GetVerifyingKeyHash() []byte {
+	if x != nil {
+		return x.VerifyingKeyHash
+	}
+	return nil
+}
+
+func (x *GetAttestationResponse) GetReportData() []byte {
+	if x != nil {
+		return x.ReportData
+	}
+	return nil
+}
+
+func (x *GetAttestationResponse) GetQuote() []byte {
+	if x != nil {
+		return x.Quote
+	}
+	return nil
+}
+
+type SnapshotEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequestHash []byte `protobuf:"bytes,1,opt,name=request_hash,json=requestHash,proto3" json:"request_hash,omitempty"`
+	// Types that are assignable to State:
+	//
+	//	*SnapshotEntry_Pending
+	//	*SnapshotEntry_Done
+	//	*SnapshotEntry_Failed
+	State   isSnapshotEntry_State `protobuf_oneof:"state"`
+	Request *ProveRequest         `protobuf:"bytes,5,opt,name=request,proto3" json:"request,omitempty"`
+	Tenant  string                `protobuf:"bytes,6,opt,name=tenant,proto3" json:"tenant,omitempty"`
+}
+
+func (x *SnapshotEntry) Reset() {
+	*x = SnapshotEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v3_galois_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SnapshotEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotEntry) ProtoMessage() {}
+
+func (x *SnapshotEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v3_galois_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotEntry.ProtoReflect.Descriptor instead.
+func (*SnapshotEntry) Descriptor() ([]byte, []int) {
+	return file_api_v3_galois_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SnapshotEntry) GetRequestHash() []byte {
+	if x != nil {
+		return x.RequestHash
+	}
+	return nil
+}
+
+func (m *SnapshotEntry) GetState() isSnapshotEntry_State {
+	if m != nil {
+		return m.State
+	}
+	return nil
+}
+
+func (x *SnapshotEntry) GetPending() *ProveRequest {
+	if x, ok := x.GetState().(*SnapshotEntry_Pending); ok {
+		return x.Pending
+	}
+	return nil
+}
+
+func (x *SnapshotEntry) GetDone() *ProveResponse {
+	if x, ok := x.GetState().(*SnapshotEntry_Done); ok {
+		return x.Done
+	}
+	return nil
+}
+
+func (x *SnapshotEntry) GetFailed() string {
+	if x, ok := x.GetState().(*SnapshotEntry_Failed); ok {
+		return x.Failed
+	}
+	return ""
+}
+
+func (x *SnapshotEntry) GetRequest() *ProveRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *SnapshotEntry) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+type isSnapshotEntry_State interface {
+	isSnapshotEntry_State()
+}
+
+type SnapshotEntry_Pending struct {
+	Pending *ProveRequest `protobuf:"bytes,2,opt,name=pending,proto3,oneof"`
+}
+
+type SnapshotEntry_Done struct {
+	Done *ProveResponse `protobuf:"bytes,3,opt,name=done,proto3,oneof"`
+}
+
+type SnapshotEntry_Failed struct {
+	Failed string `protobuf:"bytes,4,opt,name=failed,proto3,oneof"`
+}
+
+func (*SnapshotEntry_Pending) isSnapshotEntry_State() {}
+
+func (*SnapshotEntry_Done) isSnapshotEntry_State() {}
+
+func (*SnapshotEntry_Failed) isSnapshotEntry_State() {}
+
+type Snapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*SnapshotEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *Snapshot) Reset() {
+	*x = Snapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v3_galois_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Snapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Snapshot) ProtoMessage() {}
+
+func (x *Snapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v3_galois_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Snapshot.ProtoReflect.Descriptor instead.
+func (*Snapshot) Descriptor() ([]byte, []int) {
+	return file_api_v3_galois_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *Snapshot) GetEntries() []*SnapshotEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type SaveSnapshotRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SaveSnapshotRequest) Reset() {
+	*x = SaveSnapshotRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v3_galois_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SaveSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveSnapshotRequest) ProtoMessage() {}
+
+func (x *SaveSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v3_galois_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*SaveSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_api_v3_galois_proto_rawDescGZIP(), []int{26}
+}
+
+type SaveSnapshotResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Snapshot *Snapshot `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+}
+
+func (x *SaveSnapshotResponse) Reset() {
+	*x = SaveSnapshotResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v3_galois_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SaveSnapshotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveSnapshotResponse) ProtoMessage() {}
+
+func (x *SaveSnapshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v3_galois_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveSnapshotResponse.ProtoReflect.Descriptor instead.
+func (*SaveSnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_api_v3_galois_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SaveSnapshotResponse) GetSnapshot() *Snapshot {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
+type RestoreSnapshotRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Snapshot *Snapshot `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+}
+
+func (x *RestoreSnapshotRequest) Reset() {
+	*x = RestoreSnapshotRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v3_galois_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreSnapshotRequest) ProtoMessage() {}
+
+func (x *RestoreSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v3_galois_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*RestoreSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_api_v3_galois_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *RestoreSnapshotRequest) GetSnapshot() *Snapshot {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
+type RestoreSnapshotResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Restored uint32 `protobuf:"varint,1,opt,name=restored,proto3" json:"restored,omitempty"`
+	Resumed  uint32 `protobuf:"varint,2,opt,name=resumed,proto3" json:"resumed,omitempty"`
+}
+
+func (x *RestoreSnapshotResponse) Reset() {
+	*x = RestoreSnapshotResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v3_galois_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreSnapshotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreSnapshotResponse) ProtoMessage() {}
+
+func (x *RestoreSnapshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v3_galois_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreSnapshotResponse.ProtoReflect.Descriptor instead.
+func (*RestoreSnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_api_v3_galois_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *RestoreSnapshotResponse) GetRestored() uint32 {
+	if x != nil {
+		return x.Restored
+	}
+	return 0
+}
+
+func (x *RestoreSnapshotResponse) GetResumed() uint32 {
+	if x != nil {
+		return x.Resumed
+	}
+	return 0
+}
+
+type Job struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequestHash []byte                 `protobuf:"bytes,1,opt,name=request_hash,json=requestHash,proto3" json:"request_hash,omitempty"`
+	State       JobState               `protobuf:"varint,2,opt,name=state,proto3,enum=union.galois.api.v3.JobState" json:"state,omitempty"`
+	Owner       string                 `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	InputsHash  []byte                 `protobuf:"bytes,4,opt,name=inputs_hash,json=inputsHash,proto3" json:"inputs_hash,omitempty"`
+	SubmittedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at,omitempty"`
+	StartedAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	Error       string                 `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *Job) Reset() {
+	*x = Job{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v3_galois_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Job) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Job) ProtoMessage() {}
+
+func (x *Job) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v3_galois_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Job.ProtoReflect.Descriptor instead.
+func (*Job) Descriptor() ([]byte, []int) {
+	return file_api_v3_galois_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *Job) GetRequestHash() []byte {
+	if x != nil {
+		return x.RequestHash
+	}
+	return nil
+}
+
+func (x *Job) GetState() JobState {
+	if x != nil {
+		return x.State
+	}
+	return JobState_JOB_STATE_UNSPECIFIED
+}
+
+func (x *Job) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *Job) GetInputsHash() []byte {
+	if x != nil {
+		return x.InputsHash
+	}
+	return nil
+}
+
+func (x *Job) GetSubmittedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SubmittedAt
+	}
+	return nil
+}
+
+func (x *Job) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *Job) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+func (x *Job) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ListJobsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	States []JobState `protobuf:"varint,1,rep,packed,name=states,proto3,enum=union.galois.api.v3.JobState" json:"states,omitempty"`
+}
+
+func (x *ListJobsRequest) Reset() {
+	*x = ListJobsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v3_galois_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListJobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobsRequest) ProtoMessage() {}
+
+func (x *ListJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v3_galois_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobsRequest.ProtoReflect.Descriptor instead.
+func (*ListJobsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v3_galois_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListJobsRequest) GetStates() []JobState {
+	if x != nil {
+		return x.States
+	}
+	return nil
+}
+
+type ListJobsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Jobs []*Job `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+}
+
+func (x *ListJobsResponse) Reset() {
+	*x = ListJobsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v3_galois_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListJobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobsResponse) ProtoMessage() {}
+
+func (x *ListJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v3_galois_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobsResponse.ProtoReflect.Descriptor instead.
+func (*ListJobsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v3_galois_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListJobsResponse) GetJobs() []*Job {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+type GetJobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequestHash []byte `protobuf:"bytes,1,opt,name=request_hash,json=requestHash,proto3" json:"request_hash,omitempty"`
+}
+
+func (x *GetJobRequest) Reset() {
+	*x = GetJobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v3_galois_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJobRequest) ProtoMessage() {}
+
+func (x *GetJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v3_galois_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJobRequest.ProtoReflect.Descriptor instead.
+func (*GetJobRequest) Descriptor() ([]byte, []int) {
+	return file_api_v3_galois_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetJobRequest) GetRequestHash() []byte {
+	if x != nil {
+		return x.RequestHash
+	}
+	return nil
+}
+
+type GetJobResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Job *Job `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+}
+
+func (x *GetJobResponse) Reset() {
+	*x = GetJobResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v3_galois_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJobResponse) ProtoMessage() {}
+
+func (x *GetJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v3_galois_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJobResponse.ProtoReflect.Descriptor instead.
+func (*GetJobResponse) Descriptor() ([]byte, []int) {
+	return file_api_v3_galois_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetJobResponse) GetJob() *Job {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+type CancelJobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequestHash []byte `protobuf:"bytes,1,opt,name=request_hash,json=requestHash,proto3" json:"request_hash,omitempty"`
+}
+
+func (x *CancelJobRequest) Reset() {
+	*x = CancelJobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v3_galois_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelJobRequest) ProtoMessage() {}
+
+func (x *CancelJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v3_galois_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelJobRequest.ProtoReflect.Descriptor instead.
+func (*CancelJobRequest) Descriptor() ([]byte, []int) {
+	return file_api_v3_galois_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CancelJobRequest) GetRequestHash() []byte {
+	if x != nil {
+		return x.RequestHash
+	}
+	return nil
+}
+
+type CancelJobResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Job *Job `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+}
+
+func (x *CancelJobResponse) Reset() {
+	*x = CancelJobResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v3_galois_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelJobResponse) ProtoMessage() {}
+
+func (x *CancelJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v3_galois_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelJobResponse.ProtoReflect.Descriptor instead.
+func (*CancelJobResponse) Descriptor() ([]byte, []int) {
+	return file_api_v3_galois_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CancelJobResponse) GetJob() *Job {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+type GetLoadRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetLoadRequest) Reset() {
+	*x = GetLoadRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v3_galois_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLoadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLoadRequest) ProtoMessage() {}
+
+func (x *GetLoadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v3_galois_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLoadRequest.ProtoReflect.Descriptor instead.
+func (*GetLoadRequest) Descriptor() ([]byte, []int) {
+	return file_api_v3_galois_proto_rawDescGZIP(), []int{37}
+}
+
+type MaintenanceWindow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DrainAt *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=drain_at,json=drainAt,proto3" json:"drain_at,omitempty"`
+	Start   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	End     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+}
+
+func (x *MaintenanceWindow) Reset() {
+	*x = MaintenanceWindow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v3_galois_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MaintenanceWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaintenanceWindow) ProtoMessage() {}
+
+func (x *MaintenanceWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v3_galois_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaintenanceWindow.ProtoReflect.Descriptor instead.
+func (*MaintenanceWindow) Descriptor() ([]byte, []int) {
+	return file_api_v3_galois_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *MaintenanceWindow) GetDrainAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DrainAt
+	}
+	return nil
+}
+
+func (x *MaintenanceWindow) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *MaintenanceWindow) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+type GetLoadResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RunningJobs      uint32               `protobuf:"varint,1,opt,name=running_jobs,json=runningJobs,proto3" json:"running_jobs,omitempty"`
+	MaxJobs          uint32               `protobuf:"varint,2,opt,name=max_jobs,json=maxJobs,proto3" json:"max_jobs,omitempty"`
+	AverageProveTime *durationpb.Duration `protobuf:"bytes,3,opt,name=average_prove_time,json=averageProveTime,proto3" json:"average_prove_time,omitempty"`
+	EstimatedWait    *durationpb.Duration `protobuf:"bytes,4,opt,name=estimated_wait,json=estimatedWait,proto3" json:"estimated_wait,omitempty"`
+	RetryAfter       *durationpb.Duration `protobuf:"bytes,5,opt,name=retry_after,json=retryAfter,proto3" json:"retry_after,omitempty"`
+	State            ServingState         `protobuf:"varint,6,opt,name=state,proto3,enum=union.galois.api.v3.ServingState" json:"state,omitempty"`
+	Maintenance      *MaintenanceWindow   `protobuf:"bytes,7,opt,name=maintenance,proto3" json:"maintenance,omitempty"`
+	QueuedJobs       uint32               `protobuf:"varint,8,opt,name=queued_jobs,json=queuedJobs,proto3" json:"queued_jobs,omitempty"`
+	Circuits         []*CircuitLoad       `protobuf:"bytes,9,rep,name=circuits,proto3" json:"circuits,omitempty"`
+}
+
+func (x *GetLoadResponse) Reset() {
+	*x = GetLoadResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v3_galois_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLoadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLoadResponse) ProtoMessage() {}
+
+func (x *GetLoadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v3_galois_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLoadResponse.ProtoReflect.Descriptor instead.
+func (*GetLoadResponse) Descriptor() ([]byte, []int) {
+	return file_api_v3_galois_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetLoadResponse) GetRunningJobs() uint32 {
+	if x != nil {
+		return x.RunningJobs
+	}
+	return 0
+}
+
+func (x *GetLoadResponse) GetMaxJobs() uint32 {
+	if x != nil {
+		return x.MaxJobs
+	}
+	return 0
+}
+
+func (x *GetLoadResponse) GetAverageProveTime() *durationpb.Duration {
+	if x != nil {
+		return x.AverageProveTime
+	}
+	return nil
+}
+
+func (x *GetLoadResponse) GetEstimatedWait() *durationpb.Duration {
+	if x != nil {
+		return x.EstimatedWait
+	}
+	return nil
+}
+
+func (x *GetLoadResponse) GetRetryAfter() *durationpb.Duration {
+	if x != nil {
+		return x.RetryAfter
+	}
+	return nil
+}
+
+func (x *GetLoadResponse) GetState() ServingState {
+	if x != nil {
+		return x.State
+	}
+	return ServingState_SERVING_STATE_UNSPECIFIED
+}
+
+func (x *GetLoadResponse) GetMaintenance() *MaintenanceWindow {
+	if x != nil {
+		return x.Maintenance
+	}
+	return nil
+}
+
+func (x *GetLoadResponse) GetQueuedJobs() uint32 {
+	if x != nil {
+		return x.QueuedJobs
+	}
+	return 0
+}
+
+func (x *GetLoadResponse) GetCircuits() []*CircuitLoad {
+	if x != nil {
+		return x.Circuits
+	}
+	return nil
+}
+
+type CircuitLoad struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CircuitId       []byte                 `protobuf:"bytes,1,opt,name=circuit_id,json=circuitId,proto3" json:"circuit_id,omitempty"`
+	RunningJobs     uint32                 `protobuf:"varint,2,opt,name=running_jobs,json=runningJobs,proto3" json:"running_jobs,omitempty"`
+	QueuedJobs      uint32                 `protobuf:"varint,3,opt,name=queued_jobs,json=queuedJobs,proto3" json:"queued_jobs,omitempty"`
+	RecentProveTime *durationpb.Duration   `protobuf:"bytes,4,opt,name=recent_prove_time,json=recentProveTime,proto3" json:"recent_prove_time,omitempty"`
+	RecentProofs    uint32                 `protobuf:"varint,5,opt,name=recent_proofs,json=recentProofs,proto3" json:"recent_proofs,omitempty"`
+	Proofs          uint64                 `protobuf:"varint,6,opt,name=proofs,proto3" json:"proofs,omitempty"`
+	KeysState       KeysState              `protobuf:"varint,7,opt,name=keys_state,json=keysState,proto3,enum=union.galois.api.v3.KeysState" json:"keys_state,omitempty"`
+	ExpiresAt       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	ReservedSlots   uint32                 `protobuf:"varint,9,opt,name=reserved_slots,json=reservedSlots,proto3" json:"reserved_slots,omitempty"`
+	Pinned          bool                   `protobuf:"varint,10,opt,name=pinned,proto3" json:"pinned,omitempty"`
+}
+
+func (x *CircuitLoad) Reset() {
+	*x = CircuitLoad{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v3_galois_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CircuitLoad) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CircuitLoad) ProtoMessage() {}
+
+func (x *CircuitLoad) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v3_galois_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CircuitLoad.ProtoReflect.Descriptor instead.
+func (*CircuitLoad) Descriptor() ([]byte, []int) {
+	return file_api_v3_galois_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *CircuitLoad) GetCircuitId() []byte {
+	if x != nil {
+		return x.CircuitId
+	}
+	return nil
+}
+
+func (x *CircuitLoad) GetRunningJobs() uint32 {
+	if x != nil {
+		return x.RunningJobs
+	}
+	return 0
+}
+
+func (x *CircuitLoad) GetQueuedJobs() uint32 {
+	if x != nil {
+		return x.QueuedJobs
+	}
+	return 0
+}
+
+func (x *CircuitLoad) GetRecentProveTime() *durationpb.Duration {
+	if x != nil {
+		return x.RecentProveTime
+	}
+	return nil
+}
+
+func (x *CircuitLoad) GetRecentProofs() uint32 {
+	if x != nil {
+		return x.RecentProofs
+	}
+	return 0
+}
+
+func (x *CircuitLoad) GetProofs() uint64 {
+	if x != nil {
+		return x.Proofs
+	}
+	return 0
+}
+
+func (x *CircuitLoad) GetKeysState() KeysState {
+	if x != nil {
+		return x.KeysState
+	}
+	return KeysState_KEYS_STATE_UNSPECIFIED
+}
+
+func (x *CircuitLoad) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *CircuitLoad) GetReservedSlots() uint32 {
+	if x != nil {
+		return x.ReservedSlots
+	}
+	return 0
+}
+
+func (x *CircuitLoad) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
+type ScheduleMaintenanceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Window *MaintenanceWindow `protobuf:"bytes,1,opt,name=window,proto3" json:"window,omitempty"`
+}
+
+func (x *ScheduleMaintenanceRequest) Reset() {
+	*x = ScheduleMaintenanceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v3_galois_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduleMaintenanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleMaintenanceRequest) ProtoMessage() {}
+
+func (x *ScheduleMaintenanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v3_galois_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleMaintenanceRequest.ProtoReflect.Descriptor instead.
+func (*ScheduleMaintenanceRequest) Descriptor() ([]byte, []int) {
+	return file_api_v3_galois_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ScheduleMaintenanceRequest) GetWindow() *MaintenanceWindow {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+type ScheduleMaintenanceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Window *MaintenanceWindow `protobuf:"bytes,1,opt,name=window,proto3" json:"window,omitempty"`
+}
+
+func (x *ScheduleMaintenanceResponse) Reset() {
+	*x = ScheduleMaintenanceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v3_galois_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduleMaintenanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleMaintenanceResponse) ProtoMessage() {}
+
+func (x *ScheduleMaintenanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v3_galois_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleMaintenanceResponse.ProtoReflect.Descriptor instead.
+func (*ScheduleMaintenanceResponse) Descriptor() ([]byte, []int) {
+	return file_api_v3_galois_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ScheduleMaintenanceResponse) GetWindow() *MaintenanceWindow {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+type CancelMaintenanceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CancelMaintenanceRequest) Reset() {
+	*x = CancelMaintenanceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v3_galois_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelMaintenanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelMaintenanceRequest) ProtoMessage() {}
+
+func (x *CancelMaintenanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v3_galois_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelMaintenanceRequest.ProtoReflect.Descriptor instead.
+func (*CancelMaintenanceRequest) Descriptor() ([]byte, []int) {
+	return file_api_v3_galois_proto_rawDescGZIP(), []int{43}
+}
+
+type CancelMaintenanceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CancelMaintenanceResponse) Reset() {
+	*x = CancelMaintenanceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v3_galois_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelMaintenanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelMaintenanceResponse) ProtoMessage() {}
+
+func (x *CancelMaintenanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v3_galois_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelMaintenanceResponse.ProtoReflect.Descriptor instead.
+func (*CancelMaintenanceResponse) Descriptor() ([]byte, []int) {
+	return file_api_v3_galois_proto_rawDescGZIP(), []int{44}
+}
+
+type ReloadKeysRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ReloadKeysRequest) Reset() {
+	*x = ReloadKeysRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v3_galois_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReloadKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReloadKeysRequest) ProtoMessage() {}
+
+func (x *ReloadKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v3_galois_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReloadKeysRequest.ProtoReflect.Descriptor instead.
+func (*ReloadKeysRequest) Descriptor() ([]byte, []int) {
+	return file_api_v3_galois_proto_rawDescGZIP(), []int{45}
+}
+
+type ReloadKeysResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CircuitId []byte               `protobuf:"bytes,1,opt,name=circuit_id,json=circuitId,proto3" json:"circuit_id,omitempty"`
+	Elapsed   *durationpb.Duration `protobuf:"bytes,2,opt,name=elapsed,proto3" json:"elapsed,omitempty"`
+}
+
+func (x *ReloadKeysResponse) Reset() {
+	*x = ReloadKeysResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v3_galois_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReloadKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReloadKeysResponse) ProtoMessage() {}
+
+func (x *ReloadKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v3_galois_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReloadKeysResponse.ProtoReflect.Descriptor instead.
+func (*ReloadKeysResponse) Descriptor() ([]byte, []int) {
+	return file_api_v3_galois_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ReloadKeysResponse) GetCircuitId() []byte {
+	if x != nil {
+		return x.CircuitId
+	}
+	return nil
+}
+
+func (x *ReloadKeysResponse) GetElapsed() *durationpb.Duration {
+	if x != nil {
+		return x.Elapsed
+	}
+	return nil
+}
+
+type SetLogLevelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Level string `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"`
+}
+
+func (x *SetLogLevelRequest) Reset() {
+	*x = SetLogLevelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v3_galois_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetLogLevelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLogLevelRequest) ProtoMessage() {}
+
+func (x *SetLogLevelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v3_galois_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLogLevelRequest.ProtoReflect.Descriptor instead.
+func (*SetLogLevelRequest) Descriptor() ([]byte, []int) {
+	return file_api_v3_galois_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *SetLogLevelRequest) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+type SetLogLevelResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PreviousLevel string `protobuf:"bytes,1,opt,name=previous_level,json=previousLevel,proto3" json:"previous_level,omitempty"`
+}
+
+func (x *SetLogLevelResponse) Reset() {
+	*x = SetLogLevelResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v3_galois_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetLogLevelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLogLevelResponse) ProtoMessage() {}
+
+func (x *SetLogLevelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v3_galois_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLogLevelResponse.ProtoReflect.Descriptor instead.
+func (*SetLogLevelResponse) Descriptor() ([]byte, []int) {
+	return file_api_v3_galois_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *SetLogLevelResponse) GetPreviousLevel() string {
+	if x != nil {
+		return x.PreviousLevel
+	}
+	return ""
+}
+
+type ListConnectionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListConnectionsRequest) Reset() {
+	*x = ListConnectionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v3_galois_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListConnectionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConnectionsRequest) ProtoMessage() {}
+
+func (x *ListConnectionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v3_galois_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConnectionsRequest.ProtoReflect.Descriptor instead.
+func (*ListConnectionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v3_galois_proto_rawDescGZIP(), []int{49}
+}
+
+type ConnectionStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Remote       string                 `protobuf:"bytes,1,opt,name=remote,proto3" json:"remote,omitempty"`
+	Since        *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=since,proto3" json:"since,omitempty"`
+	BytesRead    uint64                 `protobuf:"varint,3,opt,name=bytes_read,json=bytesRead,proto3" json:"bytes_read,omitempty"`
+	BytesWritten uint64                 `protobuf:"varint,4,opt,name=bytes_written,json=bytesWritten,proto3" json:"bytes_written,omitempty"`
+	Requests     uint64                 `protobuf:"varint,5,opt,name=requests,proto3" json:"requests,omitempty"`
+	Stalled      *durationpb.Duration   `protobuf:"bytes,6,opt,name=stalled,proto3" json:"stalled,omitempty"`
+	Slow         bool                   `protobuf:"varint,7,opt,name=slow,proto3" json:"slow,omitempty"`
+}
+
+func (x *ConnectionStats) Reset() {
+	*x = ConnectionStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v3_galois_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConnectionStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectionStats) ProtoMessage() {}
+
+func (x *ConnectionStats) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v3_galois_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectionStats.ProtoReflect.Descriptor instead.
+func (*ConnectionStats) Descriptor() ([]byte, []int) {
+	return file_api_v3_galois_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ConnectionStats) GetRemote() string {
+	if x != nil {
+		return x.Remote
+	}
+	return ""
+}
+
+func (x *ConnectionStats) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *ConnectionStats) GetBytesRead() uint64 {
+	if x != nil {
+		return x.BytesRead
+	}
+	return 0
+}
+
+func (x *ConnectionStats) GetBytesWritten() uint64 {
+	if x != nil {
+		return x.BytesWritten
+	}
+	return 0
+}
+
+func (x *ConnectionStats) GetRequests() uint64 {
+	if x != nil {
+		return x.Requests
+	}
+	return 0
+}
+
+func (x *ConnectionStats) GetStalled() *durationpb.Duration {
+	if x != nil {
+		return x.Stalled
+	}
+	return nil
+}
+
+func (x *ConnectionStats) GetSlow() bool {
+	if x != nil {
+		return x.Slow
+	}
+	return false
+}
+
+type ListConnectionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Connections []*ConnectionStats `protobuf:"bytes,1,rep,name=connections,proto3" json:"connections,omitempty"`
+}
+
+func (x *ListConnectionsResponse) Reset() {
+	*x = ListConnectionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v3_galois_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListConnectionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConnectionsResponse) ProtoMessage() {}
+
+func (x *ListConnectionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v3_galois_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConnectionsResponse.ProtoReflect.Descriptor instead.
+func (*ListConnectionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v3_galois_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ListConnectionsResponse) GetConnections() []*ConnectionStats {
+	if x != nil {
+		return x.Connections
+	}
+	return nil
+}
+
+type UploadInputsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Request *ProveRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (x *UploadInputsRequest) Reset() {
+	*x = UploadInputsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v3_galois_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadInputsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadInputsRequest) ProtoMessage() {}
+
+func (x *UploadInputsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v3_galois_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadInputsRequest.ProtoReflect.Descriptor instead.
+func (*UploadInputsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v3_galois_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *UploadInputsRequest) GetRequest() *ProveRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type UploadInputsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Handle []byte `protobuf:"bytes,1,opt,name=handle,proto3" json:"handle,omitempty"`
+}
+
+func (x *UploadInputsResponse) Reset() {
+	*x = UploadInputsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v3_galois_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadInputsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadInputsResponse) ProtoMessage() {}
+
+func (x *UploadInputsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v3_galois_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadInputsResponse.ProtoReflect.Descriptor instead.
+func (*UploadInputsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v3_galois_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *UploadInputsResponse) GetHandle() []byte {
+	if x != nil {
+		return x.Handle
+	}
+	return nil
+}
+
+type ProveFromHandleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Handle                 []byte                 `protobuf:"bytes,1,opt,name=handle,proto3" json:"handle,omitempty"`
+	CircuitId              []byte                 `protobuf:"bytes,2,opt,name=circuit_id,json=circuitId,proto3" json:"circuit_id,omitempty"`
+	InputsCommitmentScheme InputsCommitmentScheme `protobuf:"varint,3,opt,name=inputs_commitment_scheme,json=inputsCommitmentScheme,proto3,enum=union.galois.api.v3.InputsCommitmentScheme" json:"inputs_commitment_scheme,omitempty"`
+}
+
+func (x *ProveFromHandleRequest) Reset() {
+	*x = ProveFromHandleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v3_galois_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProveFromHandleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProveFromHandleRequest) ProtoMessage() {}
+
+func (x *ProveFromHandleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v3_galois_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProveFromHandleRequest.ProtoReflect.Descriptor instead.
+func (*ProveFromHandleRequest) Descriptor() ([]byte, []int) {
+	return file_api_v3_galois_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ProveFromHandleRequest) GetHandle() []byte {
+	if x != nil {
+		return x.Handle
+	}
+	return nil
+}
+
+func (x *ProveFromHandleRequest) GetCircuitId() []byte {
+	if x != nil {
+		return x.CircuitId
+	}
+	return nil
+}
+
+func (x *ProveFromHandleRequest) GetInputsCommitmentScheme() InputsCommitmentScheme {
+	if x != nil {
+		return x.InputsCommitmentScheme
+	}
+	return InputsCommitmentScheme_INPUTS_COMMITMENT_SCHEME_UNSPECIFIED
+}
+
+type GetInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetInfoRequest) Reset() {
+	*x = GetInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v3_galois_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInfoRequest) ProtoMessage() {}
+
+func (x *GetInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v3_galois_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetInfoRequest) Descriptor() ([]byte, []int) {
+	return file_api_v3_galois_proto_rawDescGZIP(), []int{55}
+}
+
+type GetInfoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GoVersion                  string         `protobuf:"bytes,1,opt,name=go_version,json=goVersion,proto3" json:"go_version,omitempty"`
+	Os                         string         `protobuf:"bytes,2,opt,name=os,proto3" json:"os,omitempty"`
+	Arch                       string         `protobuf:"bytes,3,opt,name=arch,proto3" json:"arch,omitempty"`
+	NumCpu                     uint32         `protobuf:"varint,4,opt,name=num_cpu,json=numCpu,proto3" json:"num_cpu,omitempty"`
+	MaxProcs                   uint32         `protobuf:"varint,5,opt,name=max_procs,json=maxProcs,proto3" json:"max_procs,omitempty"`
+	AcceleratedFieldArithmetic bool           `protobuf:"varint,6,opt,name=accelerated_field_arithmetic,json=acceleratedFieldArithmetic,proto3" json:"accelerated_field_arithmetic,omitempty"`
+	CpuFeatures                []string       `protobuf:"bytes,7,rep,name=cpu_features,json=cpuFeatures,proto3" json:"cpu_features,omitempty"`
+	CircuitId                  []byte         `protobuf:"bytes,8,opt,name=circuit_id,json=circuitId,proto3" json:"circuit_id,omitempty"`
+	Circuits                   []*CircuitLoad `protobuf:"bytes,9,rep,name=circuits,proto3" json:"circuits,omitempty"`
+	CircuitProfile             string         `protobuf:"bytes,10,opt,name=circuit_profile,json=circuitProfile,proto3" json:"circuit_profile,omitempty"`
+	MaxValidators              uint32         `protobuf:"varint,11,opt,name=max_validators,json=maxValidators,proto3" json:"max_validators,omitempty"`
+}
+
+func (x *GetInfoResponse) Reset() {
+	*x = GetInfoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v3_galois_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInfoResponse) ProtoMessage() {}
+
+func (x *GetInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v3_galois_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetInfoResponse) Descriptor() ([]byte, []int) {
+	return file_api_v3_galois_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GetInfoResponse) GetGoVersion() string {
+	if x != nil {
+		return x.GoVersion
+	}
+	return ""
+}
+
+func (x *GetInfoResponse) GetOs() string {
+	if x != nil {
+		return x.Os
+	}
+	return ""
+}
+
+func (x *GetInfoResponse) GetArch() string {
+	if x != nil {
+		return x.Arch
+	}
+	return ""
+}
+
+func (x *GetInfoResponse) GetNumCpu() uint32 {
+	if x != nil {
+		return x.NumCpu
+	}
+	return 0
+}
+
+func (x *GetInfoResponse) GetMaxProcs() uint32 {
+	if x != nil {
+		return x.MaxProcs
+	}
+	return 0
+}
+
+func (x *GetInfoResponse) GetAcceleratedFieldArithmetic() bool {
+	if x != nil {
+		return x.AcceleratedFieldArithmetic
+	}
+	return false
+}
+
+func (x *GetInfoResponse) GetCpuFeatures() []string {
+	if x != nil {
+		return x.CpuFeatures
+	}
+	return nil
+}
+
+func (x *GetInfoResponse) GetCircuitId() []byte {
+	if x != nil {
+		return x.CircuitId
+	}
+	return nil
+}
+
+func (x *GetInfoResponse) GetCircuits() []*CircuitLoad {
+	if x != nil {
+		return x.Circuits
+	}
+	return nil
+}
+
+func (x *GetInfoResponse) GetCircuitProfile() string {
+	if x != nil {
+		return x.CircuitProfile
+	}
+	return ""
+}
+
+func (x *GetInfoResponse) GetMaxValidators() uint32 {
+	if x != nil {
+		return x.MaxValidators
+	}
+	return 0
+}
+
+type InputField struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *InputField) Reset() {
+	*x = InputField{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v3_galois_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InputField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InputField) ProtoMessage() {}
+
+func (x *InputField) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v3_galois_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InputField.ProtoReflect.Descriptor instead.
+func (*InputField) Descriptor() ([]byte, []int) {
+	return file_api_v3_galois_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *InputField) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InputField) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type InputFieldMismatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Client []byte `protobuf:"bytes,2,opt,name=client,proto3" json:"client,omitempty"`
+	Server []byte `protobuf:"bytes,3,opt,name=server,proto3" json:"server,omitempty"`
+}
+
+func (x *InputFieldMismatch) Reset() {
+	*x = InputFieldMismatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v3_galois_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InputFieldMismatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InputFieldMismatch) ProtoMessage() {}
+
+func (x *InputFieldMismatch) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v3_galois_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InputFieldMismatch.ProtoReflect.Descriptor instead.
+func (*InputFieldMismatch) Descriptor() ([]byte, []int) {
+	return file_api_v3_galois_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *InputFieldMismatch) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InputFieldMismatch) GetClient() []byte {
+	if x != nil {
+		return x.Client
+	}
+	return nil
+}
+
+func (x *InputFieldMismatch) GetServer() []byte {
+	if x != nil {
+		return x.Server
+	}
+	return nil
+}
+
+type DiffInputsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Request    *ProveRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	InputsHash []byte        `protobuf:"bytes,2,opt,name=inputs_hash,json=inputsHash,proto3" json:"inputs_hash,omitempty"`
+	Fields     []*InputField `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty"`
+}
+
+func (x *DiffInputsRequest) Reset() {
+	*x = DiffInputsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v3_galois_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiffInputsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffInputsRequest) ProtoMessage() {}
+
+func (x *DiffInputsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v3_galois_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffInputsRequest.ProtoReflect.Descriptor instead.
+func (*DiffInputsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v3_galois_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *DiffInputsRequest) GetRequest() *ProveRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *DiffInputsRequest) GetInputsHash() []byte {
+	if x != nil {
+		return x.InputsHash
+	}
+	return nil
+}
+
+func (x *DiffInputsRequest) GetFields() []*InputField {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type DiffInputsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Match      bool                  `protobuf:"varint,1,opt,name=match,proto3" json:"match,omitempty"`
+	InputsHash []byte                `protobuf:"bytes,2,opt,name=inputs_hash,json=inputsHash,proto3" json:"inputs_hash,omitempty"`
+	Fields     []*InputField         `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty"`
+	Mismatches []*InputFieldMismatch `protobuf:"bytes,4,rep,name=mismatches,proto3" json:"mismatches,omitempty"`
+}
+
+func (x *DiffInputsResponse) Reset() {
+	*x = DiffInputsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v3_galois_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiffInputsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffInputsResponse) ProtoMessage() {}
+
+func (x *DiffInputsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v3_galois_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffInputsResponse.ProtoReflect.Descriptor instead.
+func (*DiffInputsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v3_galois_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *DiffInputsResponse) GetMatch() bool {
+	if x != nil {
+		return x.Match
+	}
+	return false
+}
+
+func (x *DiffInputsResponse) GetInputsHash() []byte {
+	if x != nil {
+		return x.InputsHash
+	}
+	return nil
+}
+
+func (x *DiffInputsResponse) GetFields() []*InputField {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *DiffInputsResponse) GetMismatches() []*InputFieldMismatch {
+	if x != nil {
+		return x.Mismatches
+	}
+	return nil
+}
+
+type ProveStreamHeader struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Vote                   *v1.CanonicalVote      `protobuf:"bytes,1,opt,name=vote,proto3" json:"vote,omitempty"`
+	UntrustedHeader        *v1.Header             `protobuf:"bytes,2,opt,name=untrusted_header,json=untrustedHeader,proto3" json:"untrusted_header,omitempty"`
+	TrustedBitmap          []byte                 `protobuf:"bytes,3,opt,name=trusted_bitmap,json=trustedBitmap,proto3" json:"trusted_bitmap,omitempty"`
+	UntrustedBitmap        []byte                 `protobuf:"bytes,4,opt,name=untrusted_bitmap,json=untrustedBitmap,proto3" json:"untrusted_bitmap,omitempty"`
+	CircuitId              []byte                 `protobuf:"bytes,5,opt,name=circuit_id,json=circuitId,proto3" json:"circuit_id,omitempty"`
+	InputsCommitmentScheme InputsCommitmentScheme `protobuf:"varint,6,opt,name=inputs_commitment_scheme,json=inputsCommitmentScheme,proto3,enum=union.galois.api.v3.InputsCommitmentScheme" json:"inputs_commitment_scheme,omitempty"`
+}
+
+func (x *ProveStreamHeader) Reset() {
+	*x = ProveStreamHeader{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v3_galois_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProveStreamHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProveStreamHeader) ProtoMessage() {}
+
+func (x *ProveStreamHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v3_galois_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProveStreamHeader.ProtoReflect.Descriptor instead.
+func (*ProveStreamHeader) Descriptor() ([]byte, []int) {
+	return file_api_v3_galois_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ProveStreamHeader) GetVote() *v1.CanonicalVote {
+	if x != nil {
+		return x.Vote
+	}
+	return nil
+}
+
+func (x *ProveStreamHeader) GetUntrustedHeader() *v1.Header {
+	if x != nil {
+		return x.UntrustedHeader
+	}
+	return nil
+}
+
+func (x *ProveStreamHeader) GetTrustedBitmap() []byte {
+	if x != nil {
+		return x.TrustedBitmap
+	}
+	return nil
+}
+
+func (x *ProveStreamHeader) GetUntrustedBitmap() []byte {
+	if x != nil {
+		return x.UntrustedBitmap
+	}
+	return nil
+}
+
+func (x *ProveStreamHeader) GetCircuitId() []byte {
+	if x != nil {
+		return x.CircuitId
+	}
+	return nil
+}
+
+func (x *ProveStreamHeader) GetInputsCommitmentScheme() InputsCommitmentScheme {
+	if x != nil {
+		return x.InputsCommitmentScheme
+	}
+	return InputsCommitmentScheme_INPUTS_COMMITMENT_SCHEME_UNSPECIFIED
+}
+
+type ValidatorsChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Validators []*v1.SimpleValidator `protobuf:"bytes,1,rep,name=validators,proto3" json:"validators,omitempty"`
+	Signatures [][]byte              `protobuf:"bytes,2,rep,name=signatures,proto3" json:"signatures,omitempty"`
+}
+
+func (x *ValidatorsChunk) Reset() {
+	*x = ValidatorsChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v3_galois_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorsChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorsChunk) ProtoMessage() {}
+
+func (x *ValidatorsChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v3_galois_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorsChunk.ProtoReflect.Descriptor instead.
+func (*ValidatorsChunk) Descriptor() ([]byte, []int) {
+	return file_api_v3_galois_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ValidatorsChunk) GetValidators() []*v1.SimpleValidator {
+	if x != nil {
+		return x.Validators
+	}
+	return nil
+}
+
+func (x *ValidatorsChunk) GetSignatures() [][]byte {
+	if x != nil {
+		return x.Signatures
+	}
+	return nil
+}
+
+type ProveStreamChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Chunk:
+	//
+	//	*ProveStreamChunk_Header
+	//	*ProveStreamChunk_Trusted
+	//	*ProveStreamChunk_Untrusted
+	Chunk isProveStreamChunk_Chunk `protobuf_oneof:"chunk"`
+}
+
+func (x *ProveStreamChunk) Reset() {
+	*x = ProveStreamChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v3_galois_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProveStreamChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProveStreamChunk) ProtoMessage() {}
+
+func (x *ProveStreamChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v3_galois_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProveStreamChunk.ProtoReflect.Descriptor instead.
+func (*ProveStreamChunk) Descriptor() ([]byte, []int) {
+	return file_api_v3_galois_proto_rawDescGZIP(), []int{63}
+}
+
+func (m *ProveStreamChunk) GetChunk() isProveStreamChunk_Chunk {
+	if m != nil {
+		return m.Chunk
+	}
+	return nil
+}
+
+func (x *ProveStreamChunk) GetHeader() *ProveStreamHeader {
+	if x, ok := x.GetChunk().(*ProveStreamChunk_Header); ok {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *ProveStreamChunk) GetTrusted() *ValidatorsChunk {
+	if x, ok := x.GetChunk().(*ProveStreamChunk_Trusted); ok {
+		return x.Trusted
+	}
+	return nil
+}
+
+func (x *ProveStreamChunk) GetUntrusted() *ValidatorsChunk {
+	if x, ok := x.GetChunk().(*ProveStreamChunk_Untrusted); ok {
+		return x.Untrusted
+	}
+	return nil
+}
+
+type isProveStreamChunk_Chunk interface {
+	isProveStreamChunk_Chunk()
+}
+
+type ProveStreamChunk_Header struct {
+	Header *ProveStreamHeader `protobuf:"bytes,1,opt,name=header,proto3,oneof"`
+}
+
+type ProveStreamChunk_Trusted struct {
+	Trusted *ValidatorsChunk `protobuf:"bytes,2,opt,name=trusted,proto3,oneof"`
+}
+
+type ProveStreamChunk_Untrusted struct {
+	Untrusted *ValidatorsChunk `protobuf:"bytes,3,opt,name=untrusted,proto3,oneof"`
+}
+
+func (*ProveStreamChunk_Header) isProveStreamChunk_Chunk() {}
+
+func (*ProveStreamChunk_Trusted) isProveStreamChunk_Chunk() {}
+
+func (*ProveStreamChunk_Untrusted) isProveStreamChunk_Chunk() {}
+
+type ProveStreamResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Handle []byte        `protobuf:"bytes,1,opt,name=handle,proto3" json:"handle,omitempty"`
+	Result *PollResponse `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *ProveStreamResponse) Reset() {
+	*x = ProveStreamResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v3_galois_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProveStreamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProveStreamResponse) ProtoMessage() {}
+
+func (x *ProveStreamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v3_galois_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProveStreamResponse.ProtoReflect.Descriptor instead.
+func (*ProveStreamResponse) Descriptor() ([]byte, []int) {
+	return file_api_v3_galois_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ProveStreamResponse) GetHandle() []byte {
+	if x != nil {
+		return x.Handle
+	}
+	return nil
+}
+
+func (x *ProveStreamResponse) GetResult() *PollResponse {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+var File_api_v3_galois_proto protoreflect.FileDescriptor
+
+var file_api_v3_galois_proto_rawDesc = []byte{
+	0x0a, 0x13, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x33, 0x2f, 0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x13, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c,
+	0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x1a, 0x1d, 0x63, 0x6f, 0x6d, 0x65,
+	0x74, 0x62, 0x66, 0x74, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x21, 0x63, 0x6f, 0x6d, 0x65, 0x74,
+	0x62, 0x66, 0x74, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x21, 0x63, 0x6f,
+	0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x2f,
+	0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0x21, 0x0a, 0x09, 0x46, 0x72, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x22, 0x9f, 0x01, 0x0a, 0x12, 0x5a, 0x65, 0x72, 0x6f, 0x4b, 0x6e, 0x6f, 0x77,
+	0x6c, 0x65, 0x64, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x11, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x76, 0x6d, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x65, 0x76, 0x6d, 0x50, 0x72, 0x6f, 0x6f, 0x66,
+	0x12, 0x23, 0x0a, 0x0d, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49,
+	0x6e, 0x70, 0x75, 0x74, 0x73, 0x22, 0x90, 0x01, 0x0a, 0x12, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x42, 0x0a, 0x0a,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73,
+	0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0c, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x06, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x70, 0x22, 0xb4, 0x03, 0x0a, 0x0c, 0x50, 0x72, 0x6f,
+	0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x04, 0x76, 0x6f, 0x74,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62,
+	0x66, 0x74, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x6f,
+	0x6e, 0x69, 0x63, 0x61, 0x6c, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x04, 0x76, 0x6f, 0x74, 0x65, 0x12,
+	0x44, 0x0a, 0x10, 0x75, 0x6e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x6d, 0x65,
+	0x74, 0x62, 0x66, 0x74, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x52, 0x0f, 0x75, 0x6e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x4e, 0x0a, 0x0e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64,
+	0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e,
+	0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x33, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x0d, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x52, 0x0a, 0x10, 0x75, 0x6e, 0x74, 0x72, 0x75, 0x73, 0x74,
+	0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x27, 0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53,
+	0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x0f, 0x75, 0x6e, 0x74, 0x72, 0x75, 0x73,
+	0x74, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x69, 0x72,
+	0x63, 0x75, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x63,
+	0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x49, 0x64, 0x12, 0x65, 0x0a, 0x18, 0x69, 0x6e, 0x70, 0x75,
+	0x74, 0x73, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x63,
+	0x68, 0x65, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x75, 0x6e, 0x69,
+	0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33,
+	0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x52, 0x16, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x22,
+	0x6f, 0x0a, 0x10, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x43, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f,
+	0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x73,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65,
+	0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x69, 0x67, 0x65,
+	0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74,
+	0x22, 0xdf, 0x01, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3d, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x27, 0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x5a, 0x65, 0x72, 0x6f, 0x4b, 0x6e, 0x6f, 0x77,
+	0x6c, 0x65, 0x64, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x6f,
+	0x66, 0x12, 0x3b, 0x0a, 0x1a, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x17, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x52,
+	0x0a, 0x11, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d,
+	0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x75, 0x6e, 0x69, 0x6f,
+	0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x10, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65,
+	0x6e, 0x74, 0x22, 0x8e, 0x01, 0x0a, 0x0d, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f,
+	0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x5a, 0x65, 0x72, 0x6f, 0x4b, 0x6e,
+	0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x05, 0x70, 0x72,
+	0x6f, 0x6f, 0x66, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x5f, 0x68, 0x61,
+	0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73,
+	0x48, 0x61, 0x73, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69,
+	0x74, 0x49, 0x64, 0x22, 0x26, 0x0a, 0x0e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x22, 0x19, 0x0a, 0x17, 0x47,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x34, 0x0a, 0x18, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x13, 0x0a, 0x11,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0xf3, 0x01, 0x0a, 0x0d, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x6e, 0x62, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x5f, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x13, 0x6e, 0x62, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x56, 0x61,
+	0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x6e, 0x62, 0x5f, 0x73, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x5f, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x6e, 0x62, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x56, 0x61,
+	0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x6e, 0x62, 0x5f, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x5f, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x6e, 0x62, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x56, 0x61,
+	0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6e, 0x62, 0x5f, 0x63, 0x6f,
+	0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0d, 0x6e, 0x62, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x27,
+	0x0a, 0x0f, 0x6e, 0x62, 0x5f, 0x63, 0x6f, 0x65, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x74,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x6e, 0x62, 0x43, 0x6f, 0x65, 0x66, 0x66,
+	0x69, 0x63, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x3b, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x6e, 0x67, 0x4b, 0x65, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x13, 0x0a, 0x05, 0x6e, 0x62,
+	0x5f, 0x67, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x6e, 0x62, 0x47, 0x31, 0x12,
+	0x13, 0x0a, 0x05, 0x6e, 0x62, 0x5f, 0x67, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04,
+	0x6e, 0x62, 0x47, 0x32, 0x22, 0x69, 0x0a, 0x11, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x69, 0x6e,
+	0x67, 0x4b, 0x65, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x13, 0x0a, 0x05, 0x6e, 0x62, 0x5f,
+	0x67, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x6e, 0x62, 0x47, 0x31, 0x12, 0x13,
+	0x0a, 0x05, 0x6e, 0x62, 0x5f, 0x67, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x6e,
+	0x62, 0x47, 0x32, 0x12, 0x2a, 0x0a, 0x11, 0x6e, 0x62, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x5f, 0x77, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f,
+	0x6e, 0x62, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x57, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x22,
+	0x73, 0x0a, 0x0f, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x6e, 0x62, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x11, 0x6e, 0x62, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74,
+	0x65, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x6e, 0x62, 0x5f, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x12, 0x6e, 0x62, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x74, 0x65, 0x64, 0x22, 0xda, 0x02, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0e, 0x76,
+	0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f,
+	0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62,
+	0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x0d, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x50, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x6e,
+	0x67, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x4b,
+	0x65, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x6e, 0x67,
+	0x4b, 0x65, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x56, 0x0a, 0x13, 0x76, 0x65, 0x72, 0x69,
+	0x66, 0x79, 0x69, 0x6e, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61,
+	0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x56, 0x65, 0x72, 0x69,
+	0x66, 0x79, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x11, 0x76,
+	0x65, 0x72, 0x69, 0x66, 0x79, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x12, 0x4f, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73,
+	0x74, 0x61, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x75, 0x6e, 0x69,
+	0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33,
+	0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x22, 0x56, 0x0a, 0x15, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x65, 0x64, 0x50, 0x72,
+	0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x02, 0x76, 0x33,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67,
+	0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x50, 0x72, 0x6f,
+	0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x02, 0x76, 0x33, 0x42,
+	0x08, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x22, 0xa3, 0x01, 0x0a, 0x0b, 0x50, 0x6f,
+	0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x07, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x75, 0x6e, 0x69,
+	0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33,
+	0x2e, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x57, 0x0a, 0x11, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2a, 0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x65,
+	0x64, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x10, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x15, 0x0a, 0x13, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x46, 0x0a, 0x12, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x52,
+	0x0a, 0x10, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x6f,
+	0x6e, 0x65, 0x12, 0x3e, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c,
+	0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0xde, 0x01, 0x0a, 0x0c, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c,
+	0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x48, 0x00,
+	0x52, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x41, 0x0a, 0x06, 0x66, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x75, 0x6e, 0x69, 0x6f,
+	0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e,
+	0x50, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x46, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x48, 0x00, 0x52, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x3b, 0x0a, 0x04,
+	0x64, 0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x75, 0x6e, 0x69,
+	0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33,
+	0x2e, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x6f, 0x6e,
+	0x65, 0x48, 0x00, 0x52, 0x04, 0x64, 0x6f, 0x6e, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x22, 0x2d, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x6e, 0x6f, 0x6e,
+	0x63, 0x65, 0x22, 0xa0, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a,
+	0x0c, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0b, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x2c, 0x0a, 0x12, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x69, 0x6e, 0x67, 0x5f, 0x6b, 0x65,
+	0x79, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x76, 0x65,
+	0x72, 0x69, 0x66, 0x79, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1f,
+	0x0a, 0x0b, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12,
+	0x14, 0x0a, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05,
+	0x71, 0x75, 0x6f, 0x74, 0x65, 0x22, 0xa3, 0x02, 0x0a, 0x0d, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x3d, 0x0a, 0x07, 0x70, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x75, 0x6e,
+	0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x33, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00,
+	0x52, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x38, 0x0a, 0x04, 0x64, 0x6f, 0x6e,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e,
+	0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x50, 0x72,
+	0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x04, 0x64,
+	0x6f, 0x6e, 0x65, 0x12, 0x18, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x3b, 0x0a,
+	0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x33, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61,
+	0x6e, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x48, 0x0a, 0x08, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x3c, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e,
+	0x2e, 0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x15, 0x0a, 0x13, 0x53, 0x61, 0x76, 0x65, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x51, 0x0a, 0x14,
+	0x53, 0x61, 0x76, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67,
+	0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x22,
+	0x53, 0x0a, 0x16, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x08, 0x73, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x75, 0x6e,
+	0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x33, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x08, 0x73, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x22, 0x4f, 0x0a, 0x17, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x08, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x72,
+	0x65, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x72, 0x65,
+	0x73, 0x75, 0x6d, 0x65, 0x64, 0x22, 0xe1, 0x02, 0x0a, 0x03, 0x4a, 0x6f, 0x62, 0x12, 0x21, 0x0a,
+	0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x33, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1d, 0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x69,
+	0x6e, 0x70, 0x75, 0x74, 0x73, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0a, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x48, 0x61, 0x73, 0x68, 0x12, 0x3d, 0x0a, 0x0c,
+	0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b,
+	0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3b, 0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x48, 0x0a, 0x0f, 0x4c, 0x69, 0x73,
+	0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x75,
+	0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x33, 0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x73, 0x22, 0x40, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61,
+	0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x4a, 0x6f, 0x62, 0x52,
+	0x04, 0x6a, 0x6f, 0x62, 0x73, 0x22, 0x32, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x61, 0x73, 0x68, 0x22, 0x3c, 0x0a, 0x0e, 0x47, 0x65, 0x74,
+	0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x03, 0x6a,
+	0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e,
+	0x2e, 0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x4a,
+	0x6f, 0x62, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x22, 0x35, 0x0a, 0x10, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x61, 0x73, 0x68, 0x22, 0x3f,
+	0x0a, 0x11, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x22,
+	0x10, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0xaa, 0x01, 0x0a, 0x11, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63,
+	0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x35, 0x0a, 0x08, 0x64, 0x72, 0x61, 0x69, 0x6e,
+	0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x41, 0x74, 0x12, 0x30,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x12, 0x2c, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x22, 0xf8,
+	0x03, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x6a, 0x6f,
+	0x62, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e,
+	0x67, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x6a, 0x6f, 0x62,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x4a, 0x6f, 0x62, 0x73,
+	0x12, 0x47, 0x0a, 0x12, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x76,
+	0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65,
+	0x50, 0x72, 0x6f, 0x76, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x40, 0x0a, 0x0e, 0x65, 0x73, 0x74,
+	0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x65, 0x73,
+	0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x57, 0x61, 0x69, 0x74, 0x12, 0x3a, 0x0a, 0x0b, 0x72,
+	0x65, 0x74, 0x72, 0x79, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x72, 0x65, 0x74,
+	0x72, 0x79, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x37, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67,
+	0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x48, 0x0a, 0x0b, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61,
+	0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x4d, 0x61, 0x69, 0x6e,
+	0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x0b, 0x6d,
+	0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x71, 0x75,
+	0x65, 0x75, 0x65, 0x64, 0x5f, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0a, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x3c, 0x0a, 0x08, 0x63,
+	0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x33, 0x2e, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x4c, 0x6f, 0x61, 0x64, 0x52,
+	0x08, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x73, 0x22, 0xad, 0x03, 0x0a, 0x0b, 0x43, 0x69,
+	0x72, 0x63, 0x75, 0x69, 0x74, 0x4c, 0x6f, 0x61, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x69, 0x72,
+	0x63, 0x75, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x63,
+	0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x75, 0x6e, 0x6e,
+	0x69, 0x6e, 0x67, 0x5f, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b,
+	0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x71,
+	0x75, 0x65, 0x75, 0x65, 0x64, 0x5f, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0a, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x45, 0x0a, 0x11,
+	0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0f, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72,
+	0x6f, 0x6f, 0x66, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x65,
+	0x6e, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x6f, 0x6f,
+	0x66, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x73,
+	0x12, 0x3d, 0x0a, 0x0a, 0x6b, 0x65, 0x79, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c,
+	0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x4b, 0x65, 0x79, 0x73, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x09, 0x6b, 0x65, 0x79, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x53, 0x6c, 0x6f, 0x74,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x22, 0x5c, 0x0a, 0x1a, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e,
+	0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x4d, 0x61,
+	0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52,
+	0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x22, 0x5d, 0x0a, 0x1b, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67,
+	0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x4d, 0x61, 0x69,
+	0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x06,
+	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x22, 0x1a, 0x0a, 0x18, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x1b, 0x0a, 0x19, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4d, 0x61, 0x69, 0x6e,
+	0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x13, 0x0a, 0x11, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x68, 0x0a, 0x12, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x4b, 0x65,
+	0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x69,
+	0x72, 0x63, 0x75, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
+	0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x49, 0x64, 0x12, 0x33, 0x0a, 0x07, 0x65, 0x6c, 0x61,
+	0x70, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x65, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x22, 0x2a,
+	0x0a, 0x12, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x3c, 0x0a, 0x13, 0x53, 0x65,
+	0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x69,
+	0x6f, 0x75, 0x73, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x18, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x84, 0x02, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x12, 0x30,
+	0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x62, 0x79, 0x74, 0x65, 0x73, 0x52, 0x65, 0x61, 0x64, 0x12,
+	0x23, 0x0a, 0x0d, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x62, 0x79, 0x74, 0x65, 0x73, 0x57, 0x72, 0x69,
+	0x74, 0x74, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73,
+	0x12, 0x33, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x73, 0x74,
+	0x61, 0x6c, 0x6c, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x77, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x77, 0x22, 0x61, 0x0a, 0x17, 0x4c, 0x69, 0x73,
+	0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x75, 0x6e, 0x69, 0x6f,
+	0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
+	0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x52, 0x0a, 0x13,
+	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c,
+	0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x2e, 0x0a, 0x14, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x61, 0x6e, 0x64,
+	0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65,
+	0x22, 0xb6, 0x01, 0x0a, 0x16, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x48, 0x61,
+	0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68,
+	0x61, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x68, 0x61, 0x6e,
+	0x64, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74,
+	0x49, 0x64, 0x12, 0x65, 0x0a, 0x18, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x5f, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c,
+	0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x73, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d,
+	0x65, 0x52, 0x16, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d,
+	0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x22, 0x10, 0x0a, 0x0e, 0x47, 0x65, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x9c, 0x03, 0x0a, 0x0f,
+	0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x67, 0x6f, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x6f, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0e,
+	0x0a, 0x02, 0x6f, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x6f, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x61, 0x72, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72,
+	0x63, 0x68, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x75, 0x6d, 0x5f, 0x63, 0x70, 0x75, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x43, 0x70, 0x75, 0x12, 0x1b, 0x0a, 0x09, 0x6d,
+	0x61, 0x78, 0x5f, 0x70, 0x72, 0x6f, 0x63, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08,
+	0x6d, 0x61, 0x78, 0x50, 0x72, 0x6f, 0x63, 0x73, 0x12, 0x40, 0x0a, 0x1c, 0x61, 0x63, 0x63, 0x65,
+	0x6c, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x61, 0x72,
+	0x69, 0x74, 0x68, 0x6d, 0x65, 0x74, 0x69, 0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1a,
+	0x61, 0x63, 0x63, 0x65, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x41, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x65, 0x74, 0x69, 0x63, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x70,
+	0x75, 0x5f, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0b, 0x63, 0x70, 0x75, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x09, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x49, 0x64, 0x12, 0x3c, 0x0a, 0x08,
+	0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x33, 0x2e, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x4c, 0x6f, 0x61, 0x64,
+	0x52, 0x08, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x69,
+	0x72, 0x63, 0x75, 0x69, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x6d, 0x61, 0x78,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x22, 0x36, 0x0a, 0x0a, 0x49, 0x6e,
+	0x70, 0x75, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x22, 0x58, 0x0a, 0x12, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x4d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x22, 0xaa, 0x01, 0x0a,
+	0x11, 0x44, 0x69, 0x66, 0x66, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x3b, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f,
+	0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x37, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0xcd, 0x01, 0x0a, 0x12, 0x44, 0x69,
+	0x66, 0x66, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73,
+	0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x69, 0x6e, 0x70,
+	0x75, 0x74, 0x73, 0x48, 0x61, 0x73, 0x68, 0x12, 0x37, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e,
+	0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x49, 0x6e,
+	0x70, 0x75, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73,
+	0x12, 0x47, 0x0a, 0x0a, 0x6d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c,
+	0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x0a, 0x6d,
+	0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x22, 0xe7, 0x02, 0x0a, 0x11, 0x50, 0x72,
+	0x6f, 0x76, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12,
+	0x34, 0x0a, 0x04, 0x76, 0x6f, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x56, 0x6f, 0x74, 0x65, 0x52,
+	0x04, 0x76, 0x6f, 0x74, 0x65, 0x12, 0x44, 0x0a, 0x10, 0x75, 0x6e, 0x74, 0x72, 0x75, 0x73, 0x74,
+	0x65, 0x64, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x0f, 0x75, 0x6e, 0x74, 0x72,
+	0x75, 0x73, 0x74, 0x65, 0x64, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x74,
+	0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x70, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0d, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x42, 0x69, 0x74, 0x6d,
+	0x61, 0x70, 0x12, 0x29, 0x0a, 0x10, 0x75, 0x6e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x5f,
+	0x62, 0x69, 0x74, 0x6d, 0x61, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x75, 0x6e,
+	0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x42, 0x69, 0x74, 0x6d, 0x61, 0x70, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x09, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x49, 0x64, 0x12, 0x65, 0x0a, 0x18,
+	0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b,
+	0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x33, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x52, 0x16, 0x69, 0x6e, 0x70,
+	0x75, 0x74, 0x73, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68,
+	0x65, 0x6d, 0x65, 0x22, 0x75, 0x0a, 0x0f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x73, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x42, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x6f, 0x6d,
+	0x65, 0x74, 0x62, 0x66, 0x74, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x69, 0x6d, 0x70, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x0a,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0a,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x22, 0xe5, 0x01, 0x0a, 0x10, 0x50,
+	0x72, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12,
+	0x40, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x26, 0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x48, 0x00, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x12, 0x40, 0x0a, 0x07, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f, 0x69,
+	0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x73, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x48, 0x00, 0x52, 0x07, 0x74, 0x72, 0x75, 0x73,
+	0x74, 0x65, 0x64, 0x12, 0x44, 0x0a, 0x09, 0x75, 0x6e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67,
+	0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x48, 0x00, 0x52, 0x09,
+	0x75, 0x6e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x42, 0x07, 0x0a, 0x05, 0x63, 0x68, 0x75,
+	0x6e, 0x6b, 0x22, 0x68, 0x0a, 0x13, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x61, 0x6e,
+	0x64, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x68, 0x61, 0x6e, 0x64, 0x6c,
+	0x65, 0x12, 0x39, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2a, 0x8f, 0x01, 0x0a,
+	0x16, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x24, 0x49, 0x4e, 0x50, 0x55, 0x54,
+	0x53, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x43, 0x48,
+	0x45, 0x4d, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x26, 0x0a, 0x22, 0x49, 0x4e, 0x50, 0x55, 0x54, 0x53, 0x5f, 0x43, 0x4f, 0x4d, 0x4d,
+	0x49, 0x54, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x45, 0x5f, 0x4b, 0x45,
+	0x43, 0x43, 0x41, 0x4b, 0x32, 0x35, 0x36, 0x10, 0x01, 0x12, 0x23, 0x0a, 0x1f, 0x49, 0x4e, 0x50,
+	0x55, 0x54, 0x53, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53,
+	0x43, 0x48, 0x45, 0x4d, 0x45, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0x02, 0x2a, 0x95,
+	0x01, 0x0a, 0x08, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x15, 0x4a,
+	0x4f, 0x42, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x4a, 0x4f, 0x42, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x45, 0x5f, 0x51, 0x55, 0x45, 0x55, 0x45, 0x44, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11,
+	0x4a, 0x4f, 0x42, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e,
+	0x47, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x4a, 0x4f, 0x42, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45,
+	0x5f, 0x44, 0x4f, 0x4e, 0x45, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x4a, 0x4f, 0x42, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x12, 0x17, 0x0a,
+	0x13, 0x4a, 0x4f, 0x42, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45,
+	0x4c, 0x4c, 0x45, 0x44, 0x10, 0x05, 0x2a, 0x64, 0x0a, 0x0c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x6e,
+	0x67, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x45, 0x52, 0x56, 0x49, 0x4e,
+	0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x45, 0x52, 0x56, 0x49, 0x4e, 0x47,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x49, 0x4e, 0x47, 0x10, 0x01,
+	0x12, 0x1a, 0x0a, 0x16, 0x53, 0x45, 0x52, 0x56, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x45, 0x5f, 0x44, 0x52, 0x41, 0x49, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x2a, 0x51, 0x0a, 0x09,
+	0x4b, 0x65, 0x79, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x4b, 0x45, 0x59,
+	0x53, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x4b, 0x45, 0x59, 0x53, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x45, 0x5f, 0x43, 0x4f, 0x4c, 0x44, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x4b, 0x45,
+	0x59, 0x53, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x57, 0x41, 0x52, 0x4d, 0x10, 0x02, 0x32,
+	0xcc, 0x09, 0x0a, 0x0e, 0x55, 0x6e, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x41,
+	0x50, 0x49, 0x12, 0x4e, 0x0a, 0x05, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x12, 0x21, 0x2e, 0x75, 0x6e,
+	0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x33, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22,
+	0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x33, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x51, 0x0a, 0x06, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x12, 0x22, 0x2e, 0x75,
+	0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x33, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x23, 0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6f, 0x0a, 0x10, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x2c, 0x2e, 0x75, 0x6e, 0x69, 0x6f,
+	0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e,
+	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e,
+	0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x47, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x12, 0x26, 0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c,
+	0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x75,
+	0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x33, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x04, 0x50, 0x6f, 0x6c, 0x6c, 0x12, 0x20, 0x2e,
+	0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x33, 0x2e, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x21, 0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x69, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c,
+	0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x74,
+	0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2b, 0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a,
+	0x09, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4a, 0x6f, 0x62, 0x12, 0x25, 0x2e, 0x75, 0x6e, 0x69,
+	0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33,
+	0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x26, 0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4a, 0x6f,
+	0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x07, 0x47, 0x65, 0x74,
+	0x4c, 0x6f, 0x61, 0x64, 0x12, 0x23, 0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c,
+	0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f,
+	0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x75, 0x6e, 0x69, 0x6f,
+	0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e,
+	0x47, 0x65, 0x74, 0x4c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x63, 0x0a, 0x0c, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x12,
+	0x28, 0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6e, 0x70, 0x75,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x75, 0x6e, 0x69, 0x6f,
+	0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e,
+	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x46, 0x72, 0x6f,
+	0x6d, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x2b, 0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e,
+	0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x50, 0x72,
+	0x6f, 0x76, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c,
+	0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x50, 0x6f, 0x6c, 0x6c, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x23, 0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f, 0x69,
+	0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e,
+	0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x47, 0x65,
+	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a,
+	0x0a, 0x44, 0x69, 0x66, 0x66, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x12, 0x26, 0x2e, 0x75, 0x6e,
+	0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x33, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f,
+	0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x49, 0x6e,
+	0x70, 0x75, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x0b,
+	0x50, 0x72, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x25, 0x2e, 0x75, 0x6e,
+	0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x33, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x68, 0x75,
+	0x6e, 0x6b, 0x1a, 0x28, 0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f, 0x69,
+	0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x32, 0x8d,
+	0x08, 0x0a, 0x13, 0x55, 0x6e, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x41, 0x50, 0x49, 0x12, 0x63, 0x0a, 0x0c, 0x53, 0x61, 0x76, 0x65, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x28, 0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67,
+	0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x53, 0x61, 0x76,
+	0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x29, 0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6c, 0x0a, 0x0f, 0x52,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x2b,
+	0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x33, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x75, 0x6e,
+	0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x33, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x08, 0x4c, 0x69, 0x73,
+	0x74, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x24, 0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61,
+	0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x75, 0x6e,
+	0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x33, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x51, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x12, 0x22, 0x2e, 0x75,
+	0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x33, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x23, 0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x09, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4a,
+	0x6f, 0x62, 0x12, 0x25, 0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f, 0x69,
+	0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4a,
+	0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x75, 0x6e, 0x69, 0x6f,
+	0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x78, 0x0a, 0x13, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x61, 0x69,
+	0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x2f, 0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e,
+	0x2e, 0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e,
+	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x75, 0x6e, 0x69, 0x6f,
+	0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x72, 0x0a, 0x11, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65,
+	0x12, 0x2d, 0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4d, 0x61, 0x69,
+	0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2e, 0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4d, 0x61, 0x69, 0x6e,
+	0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x5d, 0x0a, 0x0a, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x26, 0x2e,
+	0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x33, 0x2e, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61,
+	0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x52, 0x65, 0x6c, 0x6f,
+	0x61, 0x64, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60,
+	0x0a, 0x0b, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x27, 0x2e,
+	0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x33, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67,
+	0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x53, 0x65, 0x74,
+	0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x6c, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x2b, 0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f,
+	0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2c, 0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x17,
+	0x5a, 0x15, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2f, 0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2f, 0x72,
+	0x70, 0x63, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_api_v3_galois_proto_rawDescOnce sync.Once
+	file_api_v3_galois_proto_rawDescData = file_api_v3_galois_proto_rawDesc
+)
+
+func file_api_v3_galois_proto_rawDescGZIP() []byte {
+	file_api_v3_galois_proto_rawDescOnce.Do(func() {
+		file_api_v3_galois_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_v3_galois_proto_rawDescData)
+	})
+	return file_api_v3_galois_proto_rawDescData
+}
+
+var file_api_v3_galois_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_api_v3_galois_proto_msgTypes = make([]protoimpl.MessageInfo, 65)
+var file_api_v3_galois_proto_goTypes = []interface{}{
+	(InputsCommitmentScheme)(0),         // 0: union.galois.api.v3.InputsCommitmentScheme
+	(JobState)(0),                       // 1: union.galois.api.v3.JobState
+	(ServingState)(0),                   // 2: union.galois.api.v3.ServingState
+	(KeysState)(0),                      // 3: union.galois.api.v3.KeysState
+	(*FrElement)(nil),                   // 4: union.galois.api.v3.FrElement
+	(*ZeroKnowledgeProof)(nil),          // 5: union.galois.api.v3.ZeroKnowledgeProof
+	(*ValidatorSetCommit)(nil),          // 6: union.galois.api.v3.ValidatorSetCommit
+	(*ProveRequest)(nil),                // 7: union.galois.api.v3.ProveRequest
+	(*InputsCommitment)(nil),            // 8: union.galois.api.v3.InputsCommitment
+	(*ProveResponse)(nil),               // 9: union.galois.api.v3.ProveResponse
+	(*VerifyRequest)(nil),               // 10: union.galois.api.v3.VerifyRequest
+	(*VerifyResponse)(nil),              // 11: union.galois.api.v3.VerifyResponse
+	(*GenerateContractRequest)(nil),     // 12: union.galois.api.v3.GenerateContractRequest
+	(*GenerateContractResponse)(nil),    // 13: union.galois.api.v3.GenerateContractResponse
+	(*QueryStatsRequest)(nil),           // 14: union.galois.api.v3.QueryStatsRequest
+	(*VariableStats)(nil),               // 15: union.galois.api.v3.VariableStats
+	(*ProvingKeyStats)(nil),             // 16: union.galois.api.v3.ProvingKeyStats
+	(*VerifyingKeyStats)(nil),           // 17: union.galois.api.v3.VerifyingKeyStats
+	(*CommitmentStats)(nil),             // 18: union.galois.api.v3.CommitmentStats
+	(*QueryStatsResponse)(nil),          // 19: union.galois.api.v3.QueryStatsResponse
+	(*VersionedProveRequest)(nil),       // 20: union.galois.api.v3.VersionedProveRequest
+	(*PollRequest)(nil),                 // 21: union.galois.api.v3.PollRequest
+	(*ProveRequestPending)(nil),         // 22: union.galois.api.v3.ProveRequestPending
+	(*ProveRequestFailed)(nil),          // 23: union.galois.api.v3.ProveRequestFailed
+	(*ProveRequestDone)(nil),            // 24: union.galois.api.v3.ProveRequestDone
+	(*PollResponse)(nil),                // 25: union.galois.api.v3.PollResponse
+	(*GetAttestationRequest)(nil),       // 26: union.galois.api.v3.GetAttestationRequest
+	(*GetAttestationResponse)(nil),      // 27: union.galois.api.v3.GetAttestationResponse
+	(*SnapshotEntry)(nil),               // 28: union.galois.api.v3.SnapshotEntry
+	(*Snapshot)(nil),                    // 29: union.galois.api.v3.Snapshot
+	(*SaveSnapshotRequest)(nil),         // 30: union.galois.api.v3.SaveSnapshotRequest
+	(*SaveSnapshotResponse)(nil),        // 31: union.galois.api.v3.SaveSnapshotResponse
+	(*RestoreSnapshotRequest)(nil),      // 32: union.galois.api.v3.RestoreSnapshotRequest
+	(*RestoreSnapshotResponse)(nil),     // 33: union.galois.api.v3.RestoreSnapshotResponse
+	(*Job)(nil),                         // 34: union.galois.api.v3.Job
+	(*ListJobsRequest)(nil),             // 35: union.galois.api.v3.ListJobsRequest
+	(*ListJobsResponse)(nil),            // 36: union.galois.api.v3.ListJobsResponse
+	(*GetJobRequest)(nil),               // 37: union.galois.api.v3.GetJobRequest
+	(*GetJobResponse)(nil),              // 38: union.galois.api.v3.GetJobResponse
+	(*CancelJobRequest)(nil),            // 39: union.galois.api.v3.CancelJobRequest
+	(*CancelJobResponse)(nil),           // 40: union.galois.api.v3.CancelJobResponse
+	(*GetLoadRequest)(nil),              // 41: union.galois.api.v3.GetLoadRequest
+	(*MaintenanceWindow)(nil),           // 42: union.galois.api.v3.MaintenanceWindow
+	(*GetLoadResponse)(nil),             // 43: union.galois.api.v3.GetLoadResponse
+	(*CircuitLoad)(nil),                 // 44: union.galois.api.v3.CircuitLoad
+	(*ScheduleMaintenanceRequest)(nil),  // 45: union.galois.api.v3.ScheduleMaintenanceRequest
+	(*ScheduleMaintenanceResponse)(nil), // 46: union.galois.api.v3.ScheduleMaintenanceResponse
+	(*CancelMaintenanceRequest)(nil),    // 47: union.galois.api.v3.CancelMaintenanceRequest
+	(*CancelMaintenanceResponse)(nil),   // 48: union.galois.api.v3.CancelMaintenanceResponse
+	(*ReloadKeysRequest)(nil),           // 49: union.galois.api.v3.ReloadKeysRequest
+	(*ReloadKeysResponse)(nil),          // 50: union.galois.api.v3.ReloadKeysResponse
+	(*SetLogLevelRequest)(nil),          // 51: union.galois.api.v3.SetLogLevelRequest
+	(*SetLogLevelResponse)(nil),         // 52: union.galois.api.v3.SetLogLevelResponse
+	(*ListConnectionsRequest)(nil),      // 53: union.galois.api.v3.ListConnectionsRequest
+	(*ConnectionStats)(nil),             // 54: union.galois.api.v3.ConnectionStats
+	(*ListConnectionsResponse)(nil),     // 55: union.galois.api.v3.ListConnectionsResponse
+	(*UploadInputsRequest)(nil),         // 56: union.galois.api.v3.UploadInputsRequest
+	(*UploadInputsResponse)(nil),        // 57: union.galois.api.v3.UploadInputsResponse
+	(*ProveFromHandleRequest)(nil),      // 58: union.galois.api.v3.ProveFromHandleRequest
+	(*GetInfoRequest)(nil),              // 59: union.galois.api.v3.GetInfoRequest
+	(*GetInfoResponse)(nil),             // 60: union.galois.api.v3.GetInfoResponse
+	(*InputField)(nil),                  // 61: union.galois.api.v3.InputField
+	(*InputFieldMismatch)(nil),          // 62: union.galois.api.v3.InputFieldMismatch
+	(*DiffInputsRequest)(nil),           // 63: union.galois.api.v3.DiffInputsRequest
+	(*DiffInputsResponse)(nil),          // 64: union.galois.api.v3.DiffInputsResponse
+	(*ProveStreamHeader)(nil),           // 65: union.galois.api.v3.ProveStreamHeader
+	(*ValidatorsChunk)(nil),             // 66: union.galois.api.v3.ValidatorsChunk
+	(*ProveStreamChunk)(nil),            // 67: union.galois.api.v3.ProveStreamChunk
+	(*ProveStreamResponse)(nil),         // 68: union.galois.api.v3.ProveStreamResponse
+	(*v1.SimpleValidator)(nil),          // 69: cometbft.types.v1.SimpleValidator
+	(*v1.CanonicalVote)(nil),            // 70: cometbft.types.v1.CanonicalVote
+	(*v1.Header)(nil),                   // 71: cometbft.types.v1.Header
+	(*timestamppb.Timestamp)(nil),       // 72: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),         // 73: google.protobuf.Duration
+}
+var file_api_v3_galois_proto_depIdxs = []int32{
+	69, // 0: union.galois.api.v3.ValidatorSetCommit.validators:type_name -> cometbft.types.v1.SimpleValidator
+	70, // 1: union.galois.api.v3.ProveRequest.vote:type_name -> cometbft.types.v1.CanonicalVote
+	71, // 2: union.galois.api.v3.ProveRequest.untrusted_header:type_name -> cometbft.types.v1.Header
+	6,  // 3: union.galois.api.v3.ProveRequest.trusted_commit:type_name -> union.galois.api.v3.ValidatorSetCommit
+	6,  // 4: union.galois.api.v3.ProveRequest.untrusted_commit:type_name -> union.galois.api.v3.ValidatorSetCommit
+	0,  // 5: union.galois.api.v3.ProveRequest.inputs_commitment_scheme:type_name -> union.galois.api.v3.InputsCommitmentScheme
+	0,  // 6: union.galois.api.v3.InputsCommitment.scheme:type_name -> union.galois.api.v3.InputsCommitmentScheme
+	5,  // 7: union.galois.api.v3.ProveResponse.proof:type_name -> union.galois.api.v3.ZeroKnowledgeProof
+	8,  // 8: union.galois.api.v3.ProveResponse.inputs_commitment:type_name -> union.galois.api.v3.InputsCommitment
+	5,  // 9: union.galois.api.v3.VerifyRequest.proof:type_name -> union.galois.api.v3.ZeroKnowledgeProof
+	15, // 10: union.galois.api.v3.QueryStatsResponse.variable_stats:type_name -> union.galois.api.v3.VariableStats
+	16, // 11: union.galois.api.v3.QueryStatsResponse.proving_key_stats:type_name -> union.galois.api.v3.ProvingKeyStats
+	17, // 12: union.galois.api.v3.QueryStatsResponse.verifying_key_stats:type_name -> union.galois.api.v3.VerifyingKeyStats
+	18, // 13: union.galois.api.v3.QueryStatsResponse.commitment_stats:type_name -> union.galois.api.v3.CommitmentStats
+	7,  // 14: union.galois.api.v3.VersionedProveRequest.v3:type_name -> union.galois.api.v3.ProveRequest
+	7,  // 15: union.galois.api.v3.PollRequest.request:type_name -> union.galois.api.v3.ProveRequest
+	20, // 16: union.galois.api.v3.PollRequest.versioned_request:type_name -> union.galois.api.v3.VersionedProveRequest
+	9,  // 17: union.galois.api.v3.ProveRequestDone.response:type_name -> union.galois.api.v3.ProveResponse
+	22, // 18: union.galois.api.v3.PollResponse.pending:type_name -> union.galois.api.v3.ProveRequestPending
+	23, // 19: union.galois.api.v3.PollResponse.failed:type_name -> union.galois.api.v3.ProveRequestFailed
+	24, // 20: union.galois.api.v3.PollResponse.done:type_name -> union.galois.api.v3.ProveRequestDone
+	7,  // 21: union.galois.api.v3.SnapshotEntry.pending:type_name -> union.galois.api.v3.ProveRequest
+	9,  // 22: union.galois.api.v3.SnapshotEntry.done:type_name -> union.galois.api.v3.ProveResponse
+	7,  // 23: union.galois.api.v3.SnapshotEntry.request:type_name -> union.galois.api.v3.ProveRequest
+	28, // 24: union.galois.api.v3.Snapshot.entries:type_name -> union.galois.api.v3.SnapshotEntry
+	29, // 25: union.galois.api.v3.SaveSnapshotResponse.snapshot:type_name -> union.galois.api.v3.Snapshot
+	29, // 26: union.galois.api.v3.RestoreSnapshotRequest.snapshot:type_name -> union.galois.api.v3.Snapshot
+	1,  // 27: union.galois.api.v3.Job.state:type_name -> union.galois.api.v3.JobState
+	72, // 28: union.galois.api.v3.Job.submitted_at:type_name -> google.protobuf.Timestamp
+	72, // 29: union.galois.api.v3.Job.started_at:type_name -> google.protobuf.Timestamp
+	72, // 30: union.galois.api.v3.Job.finished_at:type_name -> google.protobuf.Timestamp
+	1,  // 31: union.galois.api.v3.ListJobsRequest.states:type_name -> union.galois.api.v3.JobState
+	34, // 32: union.galois.api.v3.ListJobsResponse.jobs:type_name -> union.galois.api.v3.Job
+	34, // 33: union.galois.api.v3.GetJobResponse.job:type_name -> union.galois.api.v3.Job
+	34, // 34: union.galois.api.v3.CancelJobResponse.job:type_name -> union.galois.api.v3.Job
+	72, // 35: union.galois.api.v3.MaintenanceWindow.drain_at:type_name -> google.protobuf.Timestamp
+	72, // 36: union.galois.api.v3.MaintenanceWindow.start:type_name -> google.protobuf.Timestamp
+	72, // 37: union.galois.api.v3.MaintenanceWindow.end:type_name -> google.protobuf.Timestamp
+	73, // 38: union.galois.api.v3.GetLoadResponse.average_prove_time:type_name -> google.protobuf.Duration
+	73, // 39: union.galois.api.v3.GetLoadResponse.estimated_wait:type_name -> google.protobuf.Duration
+	73, // 40: union.galois.api.v3.GetLoadResponse.retry_after:type_name -> google.protobuf.Duration
+	2,  // 41: union.galois.api.v3.GetLoadResponse.state:type_name -> union.galois.api.v3.ServingState
+	42, // 42: union.galois.api.v3.GetLoadResponse.maintenance:type_name -> union.galois.api.v3.MaintenanceWindow
+	44, // 43: union.galois.api.v3.GetLoadResponse.circuits:type_name -> union.galois.api.v3.CircuitLoad
+	73, // 44: union.galois.api.v3.CircuitLoad.recent_prove_time:type_name -> google.protobuf.Duration
+	3,  // 45: union.galois.api.v3.CircuitLoad.keys_state:type_name -> union.galois.api.v3.KeysState
+	72, // 46: union.galois.api.v3.CircuitLoad.expires_at:type_name -> google.protobuf.Timestamp
+	42, // 47: union.galois.api.v3.ScheduleMaintenanceRequest.window:type_name -> union.galois.api.v3.MaintenanceWindow
+	42, // 48: union.galois.api.v3.ScheduleMaintenanceResponse.window:type_name -> union.galois.api.v3.MaintenanceWindow
+	73, // 49: union.galois.api.v3.ReloadKeysResponse.elapsed:type_name -> google.protobuf.Duration
+	72, // 50: union.galois.api.v3.ConnectionStats.since:type_name -> google.protobuf.Timestamp
+	73, // 51: union.galois.api.v3.ConnectionStats.stalled:type_name -> google.protobuf.Duration
+	54, // 52: union.galois.api.v3.ListConnectionsResponse.connections:type_name -> union.galois.api.v3.ConnectionStats
+	7,  // 53: union.galois.api.v3.UploadInputsRequest.request:type_name -> union.galois.api.v3.ProveRequest
+	0,  // 54: union.galois.api.v3.ProveFromHandleRequest.inputs_commitment_scheme:type_name -> union.galois.api.v3.InputsCommitmentScheme
+	44, // 55: union.galois.api.v3.GetInfoResponse.circuits:type_name -> union.galois.api.v3.CircuitLoad
+	7,  // 56: union.galois.api.v3.DiffInputsRequest.request:type_name -> union.galois.api.v3.ProveRequest
+	61, // 57: union.galois.api.v3.DiffInputsRequest.fields:type_name -> union.galois.api.v3.InputField
+	61, // 58: union.galois.api.v3.DiffInputsResponse.fields:type_name -> union.galois.api.v3.InputField
+	62, // 59: union.galois.api.v3.DiffInputsResponse.mismatches:type_name -> union.galois.api.v3.InputFieldMismatch
+	70, // 60: union.galois.api.v3.ProveStreamHeader.vote:type_name -> cometbft.types.v1.CanonicalVote
+	71, // 61: union.galois.api.v3.ProveStreamHeader.untrusted_header:type_name -> cometbft.types.v1.Header
+	0,  // 62: union.galois.api.v3.ProveStreamHeader.inputs_commitment_scheme:type_name -> union.galois.api.v3.InputsCommitmentScheme
+	69, // 63: union.galois.api.v3.ValidatorsChunk.validators:type_name -> cometbft.types.v1.SimpleValidator
+	65, // 64: union.galois.api.v3.ProveStreamChunk.header:type_name -> union.galois.api.v3.ProveStreamHeader
+	66, // 65: union.galois.api.v3.ProveStreamChunk.trusted:type_name -> union.galois.api.v3.ValidatorsChunk
+	66, // 66: union.galois.api.v3.ProveStreamChunk.untrusted:type_name -> union.galois.api.v3.ValidatorsChunk
+	25, // 67: union.galois.api.v3.ProveStreamResponse.result:type_name -> union.galois.api.v3.PollResponse
+	7,  // 68: union.galois.api.v3.UnionProverAPI.Prove:input_type -> union.galois.api.v3.ProveRequest
+	10, // 69: union.galois.api.v3.UnionProverAPI.Verify:input_type -> union.galois.api.v3.VerifyRequest
+	12, // 70: union.galois.api.v3.UnionProverAPI.GenerateContract:input_type -> union.galois.api.v3.GenerateContractRequest
+	14, // 71: union.galois.api.v3.UnionProverAPI.QueryStats:input_type -> union.galois.api.v3.QueryStatsRequest
+	21, // 72: union.galois.api.v3.UnionProverAPI.Poll:input_type -> union.galois.api.v3.PollRequest
+	26, // 73: union.galois.api.v3.UnionProverAPI.GetAttestation:input_type -> union.galois.api.v3.GetAttestationRequest
+	39, // 74: union.galois.api.v3.UnionProverAPI.CancelJob:input_type -> union.galois.api.v3.CancelJobRequest
+	41, // 75: union.galois.api.v3.UnionProverAPI.GetLoad:input_type -> union.galois.api.v3.GetLoadRequest
+	56, // 76: union.galois.api.v3.UnionProverAPI.UploadInputs:input_type -> union.galois.api.v3.UploadInputsRequest
+	58, // 77: union.galois.api.v3.UnionProverAPI.ProveFromHandle:input_type -> union.galois.api.v3.ProveFromHandleRequest
+	59, // 78: union.galois.api.v3.UnionProverAPI.GetInfo:input_type -> union.galois.api.v3.GetInfoRequest
+	63, // 79: union.galois.api.v3.UnionProverAPI.DiffInputs:input_type -> union.galois.api.v3.DiffInputsRequest
+	67, // 80: union.galois.api.v3.UnionProverAPI.ProveStream:input_type -> union.galois.api.v3.ProveStreamChunk
+	30, // 81: union.galois.api.v3.UnionProverAdminAPI.SaveSnapshot:input_type -> union.galois.api.v3.SaveSnapshotRequest
+	32, // 82: union.galois.api.v3.UnionProverAdminAPI.RestoreSnapshot:input_type -> union.galois.api.v3.RestoreSnapshotRequest
+	35, // 83: union.galois.api.v3.UnionProverAdminAPI.ListJobs:input_type -> union.galois.api.v3.ListJobsRequest
+	37, // 84: union.galois.api.v3.UnionProverAdminAPI.GetJob:input_type -> union.galois.api.v3.GetJobRequest
+	39, // 85: union.galois.api.v3.UnionProverAdminAPI.CancelJob:input_type -> union.galois.api.v3.CancelJobRequest
+	45, // 86: union.galois.api.v3.UnionProverAdminAPI.ScheduleMaintenance:input_type -> union.galois.api.v3.ScheduleMaintenanceRequest
+	47, // 87: union.galois.api.v3.UnionProverAdminAPI.CancelMaintenance:input_type -> union.galois.api.v3.CancelMaintenanceRequest
+	49, // 88: union.galois.api.v3.UnionProverAdminAPI.ReloadKeys:input_type -> union.galois.api.v3.ReloadKeysRequest
+	51, // 89: union.galois.api.v3.UnionProverAdminAPI.SetLogLevel:input_type -> union.galois.api.v3.SetLogLevelRequest
+	53, // 90: union.galois.api.v3.UnionProverAdminAPI.ListConnections:input_type -> union.galois.api.v3.ListConnectionsRequest
+	9,  // 91: union.galois.api.v3.UnionProverAPI.Prove:output_type -> union.galois.api.v3.ProveResponse
+	11, // 92: union.galois.api.v3.UnionProverAPI.Verify:output_type -> union.galois.api.v3.VerifyResponse
+	13, // 93: union.galois.api.v3.UnionProverAPI.GenerateContract:output_type -> union.galois.api.v3.GenerateContractResponse
+	19, // 94: union.galois.api.v3.UnionProverAPI.QueryStats:output_type -> union.galois.api.v3.QueryStatsResponse
+	25, // 95: union.galois.api.v3.UnionProverAPI.Poll:output_type -> union.galois.api.v3.PollResponse
+	27, // 96: union.galois.api.v3.UnionProverAPI.GetAttestation:output_type -> union.galois.api.v3.GetAttestationResponse
+	40, // 97: union.galois.api.v3.UnionProverAPI.CancelJob:output_type -> union.galois.api.v3.CancelJobResponse
+	43, // 98: union.galois.api.v3.UnionProverAPI.GetLoad:output_type -> union.galois.api.v3.GetLoadResponse
+	57, // 99: union.galois.api.v3.UnionProverAPI.UploadInputs:output_type -> union.galois.api.v3.UploadInputsResponse
+	25, // 100: union.galois.api.v3.UnionProverAPI.ProveFromHandle:output_type -> union.galois.api.v3.PollResponse
+	60, // 101: union.galois.api.v3.UnionProverAPI.GetInfo:output_type -> union.galois.api.v3.GetInfoResponse
+	64, // 102: union.galois.api.v3.UnionProverAPI.DiffInputs:output_type -> union.galois.api.v3.DiffInputsResponse
+	68, // 103: union.galois.api.v3.UnionProverAPI.ProveStream:output_type -> union.galois.api.v3.ProveStreamResponse
+	31, // 104: union.galois.api.v3.UnionProverAdminAPI.SaveSnapshot:output_type -> union.galois.api.v3.SaveSnapshotResponse
+	33, // 105: union.galois.api.v3.UnionProverAdminAPI.RestoreSnapshot:output_type -> union.galois.api.v3.RestoreSnapshotResponse
+	36, // 106: union.galois.api.v3.UnionProverAdminAPI.ListJobs:output_type -> union.galois.api.v3.ListJobsResponse
+	38, // 107: union.galois.api.v3.UnionProverAdminAPI.GetJob:output_type -> union.galois.api.v3.GetJobResponse
+	40, // 108: union.galois.api.v3.UnionProverAdminAPI.CancelJob:output_type -> union.galois.api.v3.CancelJobResponse
+	46, // 109: union.galois.api.v3.UnionProverAdminAPI.ScheduleMaintenance:output_type -> union.galois.api.v3.ScheduleMaintenanceResponse
+	48, // 110: union.galois.api.v3.UnionProverAdminAPI.CancelMaintenance:output_type -> union.galois.api.v3.CancelMaintenanceResponse
+	50, // 111: union.galois.api.v3.UnionProverAdminAPI.ReloadKeys:output_type -> union.galois.api.v3.ReloadKeysResponse
+	52, // 112: union.galois.api.v3.UnionProverAdminAPI.SetLogLevel:output_type -> union.galois.api.v3.SetLogLevelResponse
+	55, // 113: union.galois.api.v3.UnionProverAdminAPI.ListConnections:output_type -> union.galois.api.v3.ListConnectionsResponse
+	91, // [91:114] is the sub-list for method output_type
+	68, // [68:91] is the sub-list for method input_type
+	68, // [68:68] is the sub-list for extension type_name
+	68, // [68:68] is the sub-list for extension extendee
+	0,  // [0:68] is the sub-list for field type_name
+}
+
+func init() { file_api_v3_galois_proto_init() }
+func file_api_v3_galois_proto_init() {
+	if File_api_v3_galois_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_api_v3_galois_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FrElement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v3_galois_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ZeroKnowledgeProof); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v3_galois_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorSetCommit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v3_galois_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProveRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v3_galois_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InputsCommitment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v3_galois_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProveResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v3_galois_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v3_galois_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v3_galois_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateContractRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v3_galois_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateContractResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v3_galois_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v3_galois_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VariableStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v3_galois_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProvingKeyStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v3_galois_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyingKeyStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v3_galois_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitmentStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v3_galois_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v3_galois_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VersionedProveRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v3_galois_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PollRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v3_galois_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProveRequestPending); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v3_galois_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProveRequestFailed); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v3_galois_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProveRequestDone); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v3_galois_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PollResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v3_galois_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAttestationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v3_galois_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAttestationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v3_galois_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnapshotEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v3_galois_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Snapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v3_galois_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveSnapshotRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v3_galois_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveSnapshotResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v3_galois_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreSnapshotRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v3_galois_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreSnapshotResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v3_galois_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Job); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v3_galois_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListJobsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v3_galois_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListJobsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v3_galois_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetJobRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v3_galois_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetJobResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v3_galois_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelJobRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v3_galois_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelJobResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v3_galois_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLoadRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v3_galois_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MaintenanceWindow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v3_galois_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLoadResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v3_galois_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CircuitLoad); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v3_galois_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduleMaintenanceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use PollResponse.ProtoReflect.Descriptor instead.
-func (*PollResponse) Descriptor() ([]byte, []int) {
-	return file_api_v3_galois_proto_rawDescGZIP(), []int{19}
-}
-
-func (m *PollResponse) GetResult() isPollResponse_Result {
-	if m != nil {
-		return m.Result
-	}
-	return nil
-}
-
-func (x *PollResponse) GetPending() *ProveRequestPending {
-	if x, ok := x.GetResult().(*PollResponse_Pending); ok {
-		return x.Pending
-	}
-	return nil
-}
-
-func (x *PollResponse) GetFailed() *ProveRequestFailed {
-	if x, ok := x.GetResult().(*PollResponse_Failed); ok {
-		return x.Failed
-	}
-	return nil
-}
-
-func (x *PollResponse) GetDone() *ProveRequestDone {
-	if x, ok := x.GetResult().(*PollResponse_Done); ok {
-		return x.Done
-	}
-	return nil
-}
-
-type isPollResponse_Result interface {
-	isPollResponse_Result()
-}
-
-type PollResponse_Pending struct {
-	Pending *ProveRequestPending `protobuf:"bytes,1,opt,name=pending,proto3,oneof"`
-}
-
-type PollResponse_Failed struct {
-	Failed *ProveRequestFailed `protobuf:"bytes,2,opt,name=failed,proto3,oneof"`
-}
-
-type PollResponse_Done struct {
-	Done *ProveRequestDone `protobuf:"bytes,3,opt,name=done,proto3,oneof"`
-}
-
-func (*PollResponse_Pending) isPollResponse_Result() {}
-
-func (*PollResponse_Failed) isPollResponse_Result() {}
-
-func (*PollResponse_Done) isPollResponse_Result() {}
-
-var File_api_v3_galois_proto protoreflect.FileDescriptor
-
-var file_api_v3_galois_proto_rawDesc = []byte{
-	0x0a, 0x13, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x33, 0x2f, 0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x13, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c,
-	0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x1a, 0x1d, 0x63, 0x6f, 0x6d, 0x65,
-	0x74, 0x62, 0x66, 0x74, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x21, 0x63, 0x6f, 0x6d, 0x65, 0x74,
-	0x62, 0x66, 0x74, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x21, 0x63, 0x6f,
-	0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x2f,
-	0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
-	0x21, 0x0a, 0x09, 0x46, 0x72, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x22, 0x9f, 0x01, 0x0a, 0x12, 0x5a, 0x65, 0x72, 0x6f, 0x4b, 0x6e, 0x6f, 0x77, 0x6c,
-	0x65, 0x64, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74,
-	0x65, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65,
-	0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x11, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x65,
-	0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x76, 0x6d, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x65, 0x76, 0x6d, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12,
-	0x23, 0x0a, 0x0d, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e,
-	0x70, 0x75, 0x74, 0x73, 0x22, 0x90, 0x01, 0x0a, 0x12, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x6f, 0x72, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x42, 0x0a, 0x0a, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x22, 0x2e, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x6f, 0x72, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12,
-	0x1e, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x0c, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12,
-	0x16, 0x0a, 0x06, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x06, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x70, 0x22, 0xae, 0x02, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x76,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x04, 0x76, 0x6f, 0x74, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66,
-	0x74, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x6f, 0x6e,
-	0x69, 0x63, 0x61, 0x6c, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x04, 0x76, 0x6f, 0x74, 0x65, 0x12, 0x44,
-	0x0a, 0x10, 0x75, 0x6e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x68, 0x65, 0x61, 0x64,
-	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x6d, 0x65, 0x74,
-	0x62, 0x66, 0x74, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x52, 0x0f, 0x75, 0x6e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x48, 0x65,
-	0x61, 0x64, 0x65, 0x72, 0x12, 0x4e, 0x0a, 0x0e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x5f,
-	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x75,
-	0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x76, 0x33, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x43,
-	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x0d, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x43, 0x6f,
-	0x6d, 0x6d, 0x69, 0x74, 0x12, 0x52, 0x0a, 0x10, 0x75, 0x6e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65,
-	0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27,
-	0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x33, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65,
-	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x0f, 0x75, 0x6e, 0x74, 0x72, 0x75, 0x73, 0x74,
-	0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x22, 0x8b, 0x01, 0x0a, 0x0d, 0x50, 0x72, 0x6f,
-	0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x05, 0x70, 0x72,
-	0x6f, 0x6f, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x75, 0x6e, 0x69, 0x6f,
-	0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e,
-	0x5a, 0x65, 0x72, 0x6f, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x50, 0x72, 0x6f,
-	0x6f, 0x66, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x3b, 0x0a, 0x1a, 0x74, 0x72, 0x75,
-	0x73, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x73,
-	0x65, 0x74, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x17, 0x74,
-	0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53,
-	0x65, 0x74, 0x52, 0x6f, 0x6f, 0x74, 0x22, 0x6f, 0x0a, 0x0d, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67,
-	0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x5a, 0x65, 0x72,
-	0x6f, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52,
-	0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73,
-	0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x69, 0x6e, 0x70,
-	0x75, 0x74, 0x73, 0x48, 0x61, 0x73, 0x68, 0x22, 0x26, 0x0a, 0x0e, 0x56, 0x65, 0x72, 0x69, 0x66,
-	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x22,
-	0x19, 0x0a, 0x17, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72,
-	0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x34, 0x0a, 0x18, 0x47, 0x65,
-	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-	0x22, 0x13, 0x0a, 0x11, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xf3, 0x01, 0x0a, 0x0d, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62,
-	0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x6e, 0x62, 0x5f, 0x69, 0x6e,
-	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x6e, 0x62, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e,
-	0x61, 0x6c, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x6e,
-	0x62, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c,
-	0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x6e, 0x62, 0x53, 0x65, 0x63, 0x72,
-	0x65, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x6e,
-	0x62, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c,
-	0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x6e, 0x62, 0x50, 0x75, 0x62, 0x6c,
-	0x69, 0x63, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6e,
-	0x62, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x0d, 0x6e, 0x62, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e,
-	0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x6e, 0x62, 0x5f, 0x63, 0x6f, 0x65, 0x66, 0x66, 0x69, 0x63,
-	0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x6e, 0x62, 0x43,
-	0x6f, 0x65, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x3b, 0x0a, 0x0f, 0x50,
-	0x72, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x13,
-	0x0a, 0x05, 0x6e, 0x62, 0x5f, 0x67, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x6e,
-	0x62, 0x47, 0x31, 0x12, 0x13, 0x0a, 0x05, 0x6e, 0x62, 0x5f, 0x67, 0x32, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x04, 0x6e, 0x62, 0x47, 0x32, 0x22, 0x69, 0x0a, 0x11, 0x56, 0x65, 0x72, 0x69,
-	0x66, 0x79, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x13, 0x0a,
-	0x05, 0x6e, 0x62, 0x5f, 0x67, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x6e, 0x62,
-	0x47, 0x31, 0x12, 0x13, 0x0a, 0x05, 0x6e, 0x62, 0x5f, 0x67, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x04, 0x6e, 0x62, 0x47, 0x32, 0x12, 0x2a, 0x0a, 0x11, 0x6e, 0x62, 0x5f, 0x70, 0x75,
-	0x62, 0x6c, 0x69, 0x63, 0x5f, 0x77, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x0f, 0x6e, 0x62, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x57, 0x69, 0x74, 0x6e,
-	0x65, 0x73, 0x73, 0x22, 0x73, 0x0a, 0x0f, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e,
-	0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x6e, 0x62, 0x5f, 0x70, 0x75, 0x62,
-	0x6c, 0x69, 0x63, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x11, 0x6e, 0x62, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x43, 0x6f, 0x6d,
-	0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x6e, 0x62, 0x5f, 0x70, 0x72, 0x69,
-	0x76, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x6e, 0x62, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x43,
-	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x22, 0xda, 0x02, 0x0a, 0x12, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x49, 0x0a, 0x0e, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e,
-	0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x56, 0x61,
-	0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x0d, 0x76, 0x61, 0x72,
-	0x69, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x50, 0x0a, 0x11, 0x70, 0x72,
-	0x6f, 0x76, 0x69, 0x6e, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61,
-	0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x50, 0x72, 0x6f, 0x76,
-	0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x0f, 0x70, 0x72, 0x6f,
-	0x76, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x56, 0x0a, 0x13,
-	0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x69, 0x6e, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x74,
-	0x61, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x75, 0x6e, 0x69, 0x6f,
-	0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e,
-	0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x53, 0x74, 0x61, 0x74,
-	0x73, 0x52, 0x11, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x53,
-	0x74, 0x61, 0x74, 0x73, 0x12, 0x4f, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65,
-	0x6e, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24,
-	0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x33, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x53,
-	0x74, 0x61, 0x74, 0x73, 0x52, 0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74,
-	0x53, 0x74, 0x61, 0x74, 0x73, 0x22, 0x4a, 0x0a, 0x0b, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61,
-	0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x50, 0x72, 0x6f, 0x76,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x22, 0x15, 0x0a, 0x13, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x2e, 0x0a, 0x12, 0x50, 0x72, 0x6f, 0x76,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x18,
-	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x52, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x76,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x6f, 0x6e, 0x65, 0x12, 0x3e, 0x0a, 0x08,
-	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22,
-	0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x33, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xde, 0x01, 0x0a,
-	0x0c, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a,
-	0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28,
-	0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x33, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x48, 0x00, 0x52, 0x07, 0x70, 0x65, 0x6e, 0x64,
-	0x69, 0x6e, 0x67, 0x12, 0x41, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f,
-	0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x48, 0x00, 0x52, 0x06,
-	0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x3b, 0x0a, 0x04, 0x64, 0x6f, 0x6e, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c,
-	0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x6f, 0x6e, 0x65, 0x48, 0x00, 0x52, 0x04, 0x64,
-	0x6f, 0x6e, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x32, 0xd0, 0x03,
-	0x0a, 0x0e, 0x55, 0x6e, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x41, 0x50, 0x49,
-	0x12, 0x4e, 0x0a, 0x05, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x12, 0x21, 0x2e, 0x75, 0x6e, 0x69, 0x6f,
-	0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e,
-	0x50, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x75,
-	0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x76, 0x33, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x51, 0x0a, 0x06, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x12, 0x22, 0x2e, 0x75, 0x6e, 0x69,
-	0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33,
-	0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23,
-	0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x33, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x6f, 0x0a, 0x10, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43,
-	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x2c, 0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e,
-	0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x47, 0x65,
-	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61,
-	0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x47, 0x65, 0x6e, 0x65,
-	0x72, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61,
-	0x74, 0x73, 0x12, 0x26, 0x2e, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f, 0x69,
-	0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74,
-	0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x75, 0x6e, 0x69,
-	0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x04, 0x50, 0x6f, 0x6c, 0x6c, 0x12, 0x20, 0x2e, 0x75, 0x6e,
-	0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
-	0x33, 0x2e, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
-	0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x76, 0x33, 0x2e, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x42, 0x17, 0x5a, 0x15, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2f, 0x67, 0x61, 0x6c, 0x6f, 0x69, 0x73,
-	0x2f, 0x72, 0x70, 0x63, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
-}
-
-var (
-	file_api_v3_galois_proto_rawDescOnce sync.Once
-	file_api_v3_galois_proto_rawDescData = file_api_v3_galois_proto_rawDesc
-)
-
-func file_api_v3_galois_proto_rawDescGZIP() []byte {
-	file_api_v3_galois_proto_rawDescOnce.Do(func() {
-		file_api_v3_galois_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_v3_galois_proto_rawDescData)
-	})
-	return file_api_v3_galois_proto_rawDescData
-}
-
-var file_api_v3_galois_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
-var file_api_v3_galois_proto_goTypes = []interface{}{
-	(*FrElement)(nil),                // 0: union.galois.api.v3.FrElement
-	(*ZeroKnowledgeProof)(nil),       // 1: union.galois.api.v3.ZeroKnowledgeProof
-	(*ValidatorSetCommit)(nil),       // 2: union.galois.api.v3.ValidatorSetCommit
-	(*ProveRequest)(nil),             // 3: union.galois.api.v3.ProveRequest
-	(*ProveResponse)(nil),            // 4: union.galois.api.v3.ProveResponse
-	(*VerifyRequest)(nil),            // 5: union.galois.api.v3.VerifyRequest
-	(*VerifyResponse)(nil),           // 6: union.galois.api.v3.VerifyResponse
-	(*GenerateContractRequest)(nil),  // 7: union.galois.api.v3.GenerateContractRequest
-	(*GenerateContractResponse)(nil), // 8: union.galois.api.v3.GenerateContractResponse
-	(*QueryStatsRequest)(nil),        // 9: union.galois.api.v3.QueryStatsRequest
-	(*VariableStats)(nil),            // 10: union.galois.api.v3.VariableStats
-	(*ProvingKeyStats)(nil),          // 11: union.galois.api.v3.ProvingKeyStats
-	(*VerifyingKeyStats)(nil),        // 12: union.galois.api.v3.VerifyingKeyStats
-	(*CommitmentStats)(nil),          // 13: union.galois.api.v3.CommitmentStats
-	(*QueryStatsResponse)(nil),       // 14: union.galois.api.v3.QueryStatsResponse
-	(*PollRequest)(nil),              // 15: union.galois.api.v3.PollRequest
-	(*ProveRequestPending)(nil),      // 16: union.galois.api.v3.ProveRequestPending
-	(*ProveRequestFailed)(nil),       // 17: union.galois.api.v3.ProveRequestFailed
-	(*ProveRequestDone)(nil),         // 18: union.galois.api.v3.ProveRequestDone
-	(*PollResponse)(nil),             // 19: union.galois.api.v3.PollResponse
-	(*v1.SimpleValidator)(nil),       // 20: cometbft.types.v1.SimpleValidator
-	(*v1.CanonicalVote)(nil),         // 21: cometbft.types.v1.CanonicalVote
-	(*v1.Header)(nil),                // 22: cometbft.types.v1.Header
-}
-var file_api_v3_galois_proto_depIdxs = []int32{
-	20, // 0: union.galois.api.v3.ValidatorSetCommit.validators:type_name -> cometbft.types.v1.SimpleValidator
-	21, // 1: union.galois.api.v3.ProveRequest.vote:type_name -> cometbft.types.v1.CanonicalVote
-	22, // 2: union.galois.api.v3.ProveRequest.untrusted_header:type_name -> cometbft.types.v1.Header
-	2,  // 3: union.galois.api.v3.ProveRequest.trusted_commit:type_name -> union.galois.api.v3.ValidatorSetCommit
-	2,  // 4: union.galois.api.v3.ProveRequest.untrusted_commit:type_name -> union.galois.api.v3.ValidatorSetCommit
-	1,  // 5: union.galois.api.v3.ProveResponse.proof:type_name -> union.galois.api.v3.ZeroKnowledgeProof
-	1,  // 6: union.galois.api.v3.VerifyRequest.proof:type_name -> union.galois.api.v3.ZeroKnowledgeProof
-	10, // 7: union.galois.api.v3.QueryStatsResponse.variable_stats:type_name -> union.galois.api.v3.VariableStats
-	11, // 8: union.galois.api.v3.QueryStatsResponse.proving_key_stats:type_name -> union.galois.api.v3.ProvingKeyStats
-	12, // 9: union.galois.api.v3.QueryStatsResponse.verifying_key_stats:type_name -> union.galois.api.v3.VerifyingKeyStats
-	13, // 10: union.galois.api.v3.QueryStatsResponse.commitment_stats:type_name -> union.galois.api.v3.CommitmentStats
-	3,  // 11: union.galois.api.v3.PollRequest.request:type_name -> union.galois.api.v3.ProveRequest
-	4,  // 12: union.galois.api.v3.ProveRequestDone.response:type_name -> union.galois.api.v3.ProveResponse
-	16, // 13: union.galois.api.v3.PollResponse.pending:type_name -> union.galois.api.v3.ProveRequestPending
-	17, // 14: union.galois.api.v3.PollResponse.failed:type_name -> union.galois.api.v3.ProveRequestFailed
-	18, // 15: union.galois.api.v3.PollResponse.done:type_name -> union.galois.api.v3.ProveRequestDone
-	3,  // 16: union.galois.api.v3.UnionProverAPI.Prove:input_type -> union.galois.api.v3.ProveRequest
-	5,  // 17: union.galois.api.v3.UnionProverAPI.Verify:input_type -> union.galois.api.v3.VerifyRequest
-	7,  // 18: union.galois.api.v3.UnionProverAPI.GenerateContract:input_type -> union.galois.api.v3.GenerateContractRequest
-	9,  // 19: union.galois.api.v3.UnionProverAPI.QueryStats:input_type -> union.galois.api.v3.QueryStatsRequest
-	15, // 20: union.galois.api.v3.UnionProverAPI.Poll:input_type -> union.galois.api.v3.PollRequest
-	4,  // 21: union.galois.api.v3.UnionProverAPI.Prove:output_type -> union.galois.api.v3.ProveResponse
-	6,  // 22: union.galois.api.v3.UnionProverAPI.Verify:output_type -> union.galois.api.v3.VerifyResponse
-	8,  // 23: union.galois.api.v3.UnionProverAPI.GenerateContract:output_type -> union.galois.api.v3.GenerateContractResponse
-	14, // 24: union.galois.api.v3.UnionProverAPI.QueryStats:output_type -> union.galois.api.v3.QueryStatsResponse
-	19, // 25: union.galois.api.v3.UnionProverAPI.Poll:output_type -> union.galois.api.v3.PollResponse
-	21, // [21:26] is the sub-list for method output_type
-	16, // [16:21] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
-}
-
-func init() { file_api_v3_galois_proto_init() }
-func file_api_v3_galois_proto_init() {
-	if File_api_v3_galois_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_api_v3_galois_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FrElement); i {
+		file_api_v3_galois_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduleMaintenanceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1435,8 +5582,8 @@ func file_api_v3_galois_proto_init() {
 				return nil
 			}
 		}
-		file_api_v3_galois_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ZeroKnowledgeProof); i {
+		file_api_v3_galois_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelMaintenanceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1447,8 +5594,8 @@ func file_api_v3_galois_proto_init() {
 				return nil
 			}
 		}
-		file_api_v3_galois_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidatorSetCommit); i {
+		file_api_v3_galois_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelMaintenanceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1459,8 +5606,8 @@ func file_api_v3_galois_proto_init() {
 				return nil
 			}
 		}
-		file_api_v3_galois_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProveRequest); i {
+		file_api_v3_galois_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReloadKeysRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1471,8 +5618,8 @@ func file_api_v3_galois_proto_init() {
 				return nil
 			}
 		}
-		file_api_v3_galois_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProveResponse); i {
+		file_api_v3_galois_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReloadKeysResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1483,8 +5630,8 @@ func file_api_v3_galois_proto_init() {
 				return nil
 			}
 		}
-		file_api_v3_galois_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VerifyRequest); i {
+		file_api_v3_galois_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetLogLevelRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1495,8 +5642,8 @@ func file_api_v3_galois_proto_init() {
 				return nil
 			}
 		}
-		file_api_v3_galois_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VerifyResponse); i {
+		file_api_v3_galois_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetLogLevelResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1507,8 +5654,8 @@ func file_api_v3_galois_proto_init() {
 				return nil
 			}
 		}
-		file_api_v3_galois_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenerateContractRequest); i {
+		file_api_v3_galois_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListConnectionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1519,8 +5666,8 @@ func file_api_v3_galois_proto_init() {
 				return nil
 			}
 		}
-		file_api_v3_galois_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenerateContractResponse); i {
+		file_api_v3_galois_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnectionStats); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1531,8 +5678,8 @@ func file_api_v3_galois_proto_init() {
 				return nil
 			}
 		}
-		file_api_v3_galois_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryStatsRequest); i {
+		file_api_v3_galois_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListConnectionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1543,8 +5690,8 @@ func file_api_v3_galois_proto_init() {
 				return nil
 			}
 		}
-		file_api_v3_galois_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VariableStats); i {
+		file_api_v3_galois_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadInputsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1555,8 +5702,8 @@ func file_api_v3_galois_proto_init() {
 				return nil
 			}
 		}
-		file_api_v3_galois_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProvingKeyStats); i {
+		file_api_v3_galois_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadInputsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1567,8 +5714,8 @@ func file_api_v3_galois_proto_init() {
 				return nil
 			}
 		}
-		file_api_v3_galois_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VerifyingKeyStats); i {
+		file_api_v3_galois_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProveFromHandleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1579,8 +5726,8 @@ func file_api_v3_galois_proto_init() {
 				return nil
 			}
 		}
-		file_api_v3_galois_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CommitmentStats); i {
+		file_api_v3_galois_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInfoRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1591,8 +5738,8 @@ func file_api_v3_galois_proto_init() {
 				return nil
 			}
 		}
-		file_api_v3_galois_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryStatsResponse); i {
+		file_api_v3_galois_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInfoResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1603,8 +5750,8 @@ func file_api_v3_galois_proto_init() {
 				return nil
 			}
 		}
-		file_api_v3_galois_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PollRequest); i {
+		file_api_v3_galois_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InputField); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1615,8 +5762,8 @@ func file_api_v3_galois_proto_init() {
 				return nil
 			}
 		}
-		file_api_v3_galois_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProveRequestPending); i {
+		file_api_v3_galois_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InputFieldMismatch); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1627,8 +5774,8 @@ func file_api_v3_galois_proto_init() {
 				return nil
 			}
 		}
-		file_api_v3_galois_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProveRequestFailed); i {
+		file_api_v3_galois_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffInputsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1639,8 +5786,8 @@ func file_api_v3_galois_proto_init() {
 				return nil
 			}
 		}
-		file_api_v3_galois_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProveRequestDone); i {
+		file_api_v3_galois_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffInputsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1651,8 +5798,44 @@ func file_api_v3_galois_proto_init() {
 				return nil
 			}
 		}
-		file_api_v3_galois_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PollResponse); i {
+		file_api_v3_galois_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProveStreamHeader); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v3_galois_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorsChunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v3_galois_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProveStreamChunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v3_galois_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProveStreamResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1664,23 +5847,37 @@ func file_api_v3_galois_proto_init() {
 			}
 		}
 	}
-	file_api_v3_galois_proto_msgTypes[19].OneofWrappers = []interface{}{
+	file_api_v3_galois_proto_msgTypes[16].OneofWrappers = []interface{}{
+		(*VersionedProveRequest_V3)(nil),
+	}
+	file_api_v3_galois_proto_msgTypes[21].OneofWrappers = []interface{}{
 		(*PollResponse_Pending)(nil),
 		(*PollResponse_Failed)(nil),
 		(*PollResponse_Done)(nil),
 	}
+	file_api_v3_galois_proto_msgTypes[24].OneofWrappers = []interface{}{
+		(*SnapshotEntry_Pending)(nil),
+		(*SnapshotEntry_Done)(nil),
+		(*SnapshotEntry_Failed)(nil),
+	}
+	file_api_v3_galois_proto_msgTypes[63].OneofWrappers = []interface{}{
+		(*ProveStreamChunk_Header)(nil),
+		(*ProveStreamChunk_Trusted)(nil),
+		(*ProveStreamChunk_Untrusted)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_v3_galois_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   20,
+			NumEnums:      4,
+			NumMessages:   65,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_api_v3_galois_proto_goTypes,
 		DependencyIndexes: file_api_v3_galois_proto_depIdxs,
+		EnumInfos:         file_api_v3_galois_proto_enumTypes,
 		MessageInfos:      file_api_v3_galois_proto_msgTypes,
 	}.Build()
 	File_api_v3_galois_proto = out.File
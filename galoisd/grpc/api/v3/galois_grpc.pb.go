@@ -24,6 +24,14 @@ const (
 	UnionProverAPI_GenerateContract_FullMethodName = "/union.galois.api.v3.UnionProverAPI/GenerateContract"
 	UnionProverAPI_QueryStats_FullMethodName       = "/union.galois.api.v3.UnionProverAPI/QueryStats"
 	UnionProverAPI_Poll_FullMethodName             = "/union.galois.api.v3.UnionProverAPI/Poll"
+	UnionProverAPI_GetAttestation_FullMethodName   = "/union.galois.api.v3.UnionProverAPI/GetAttestation"
+	UnionProverAPI_CancelJob_FullMethodName        = "/union.galois.api.v3.UnionProverAPI/CancelJob"
+	UnionProverAPI_GetLoad_FullMethodName          = "/union.galois.api.v3.UnionProverAPI/GetLoad"
+	UnionProverAPI_UploadInputs_FullMethodName     = "/union.galois.api.v3.UnionProverAPI/UploadInputs"
+	UnionProverAPI_ProveFromHandle_FullMethodName  = "/union.galois.api.v3.UnionProverAPI/ProveFromHandle"
+	UnionProverAPI_GetInfo_FullMethodName          = "/union.galois.api.v3.UnionProverAPI/GetInfo"
+	UnionProverAPI_DiffInputs_FullMethodName       = "/union.galois.api.v3.UnionProverAPI/DiffInputs"
+	UnionProverAPI_ProveStream_FullMethodName      = "/union.galois.api.v3.UnionProverAPI/ProveStream"
 )
 
 // UnionProverAPIClient is the client API for UnionProverAPI service.
@@ -35,6 +43,14 @@ type UnionProverAPIClient interface {
 	GenerateContract(ctx context.Context, in *GenerateContractRequest, opts ...grpc.CallOption) (*GenerateContractResponse, error)
 	QueryStats(ctx context.Context, in *QueryStatsRequest, opts ...grpc.CallOption) (*QueryStatsResponse, error)
 	Poll(ctx context.Context, in *PollRequest, opts ...grpc.CallOption) (*PollResponse, error)
+	GetAttestation(ctx context.Context, in *GetAttestationRequest, opts ...grpc.CallOption) (*GetAttestationResponse, error)
+	CancelJob(ctx context.Context, in *CancelJobRequest, opts ...grpc.CallOption) (*CancelJobResponse, error)
+	GetLoad(ctx context.Context, in *GetLoadRequest, opts ...grpc.CallOption) (*GetLoadResponse, error)
+	UploadInputs(ctx context.Context, in *UploadInputsRequest, opts ...grpc.CallOption) (*UploadInputsResponse, error)
+	ProveFromHandle(ctx context.Context, in *ProveFromHandleRequest, opts ...grpc.CallOption) (*PollResponse, error)
+	GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error)
+	DiffInputs(ctx context.Context, in *DiffInputsRequest, opts ...grpc.CallOption) (*DiffInputsResponse, error)
+	ProveStream(ctx context.Context, opts ...grpc.CallOption) (UnionProverAPI_ProveStreamClient, error)
 }
 
 type unionProverAPIClient struct {
@@ -90,6 +106,103 @@ func (c *unionProverAPIClient) Poll(ctx context.Context, in *PollRequest, opts .
 	return out, nil
 }
 
+func (c *unionProverAPIClient) GetAttestation(ctx context.Context, in *GetAttestationRequest, opts ...grpc.CallOption) (*GetAttestationResponse, error) {
+	out := new(GetAttestationResponse)
+	err := c.cc.Invoke(ctx, UnionProverAPI_GetAttestation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *unionProverAPIClient) CancelJob(ctx context.Context, in *CancelJobRequest, opts ...grpc.CallOption) (*CancelJobResponse, error) {
+	out := new(CancelJobResponse)
+	err := c.cc.Invoke(ctx, UnionProverAPI_CancelJob_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *unionProverAPIClient) GetLoad(ctx context.Context, in *GetLoadRequest, opts ...grpc.CallOption) (*GetLoadResponse, error) {
+	out := new(GetLoadResponse)
+	err := c.cc.Invoke(ctx, UnionProverAPI_GetLoad_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *unionProverAPIClient) UploadInputs(ctx context.Context, in *UploadInputsRequest, opts ...grpc.CallOption) (*UploadInputsResponse, error) {
+	out := new(UploadInputsResponse)
+	err := c.cc.Invoke(ctx, UnionProverAPI_UploadInputs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *unionProverAPIClient) ProveFromHandle(ctx context.Context, in *ProveFromHandleRequest, opts ...grpc.CallOption) (*PollResponse, error) {
+	out := new(PollResponse)
+	err := c.cc.Invoke(ctx, UnionProverAPI_ProveFromHandle_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *unionProverAPIClient) GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error) {
+	out := new(GetInfoResponse)
+	err := c.cc.Invoke(ctx, UnionProverAPI_GetInfo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *unionProverAPIClient) DiffInputs(ctx context.Context, in *DiffInputsRequest, opts ...grpc.CallOption) (*DiffInputsResponse, error) {
+	out := new(DiffInputsResponse)
+	err := c.cc.Invoke(ctx, UnionProverAPI_DiffInputs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *unionProverAPIClient) ProveStream(ctx context.Context, opts ...grpc.CallOption) (UnionProverAPI_ProveStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &UnionProverAPI_ServiceDesc.Streams[0], UnionProverAPI_ProveStream_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &unionProverAPIProveStreamClient{stream}
+	return x, nil
+}
+
+type UnionProverAPI_ProveStreamClient interface {
+	Send(*ProveStreamChunk) error
+	CloseAndRecv() (*ProveStreamResponse, error)
+	grpc.ClientStream
+}
+
+type unionProverAPIProveStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *unionProverAPIProveStreamClient) Send(m *ProveStreamChunk) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *unionProverAPIProveStreamClient) CloseAndRecv() (*ProveStreamResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ProveStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // UnionProverAPIServer is the server API for UnionProverAPI service.
 // All implementations must embed UnimplementedUnionProverAPIServer
 // for forward compatibility
@@ -99,6 +212,14 @@ type UnionProverAPIServer interface {
 	GenerateContract(context.Context, *GenerateContractRequest) (*GenerateContractResponse, error)
 	QueryStats(context.Context, *QueryStatsRequest) (*QueryStatsResponse, error)
 	Poll(context.Context, *PollRequest) (*PollResponse, error)
+	GetAttestation(context.Context, *GetAttestationRequest) (*GetAttestationResponse, error)
+	CancelJob(context.Context, *CancelJobRequest) (*CancelJobResponse, error)
+	GetLoad(context.Context, *GetLoadRequest) (*GetLoadResponse, error)
+	UploadInputs(context.Context, *UploadInputsRequest) (*UploadInputsResponse, error)
+	ProveFromHandle(context.Context, *ProveFromHandleRequest) (*PollResponse, error)
+	GetInfo(context.Context, *GetInfoRequest) (*GetInfoResponse, error)
+	DiffInputs(context.Context, *DiffInputsRequest) (*DiffInputsResponse, error)
+	ProveStream(UnionProverAPI_ProveStreamServer) error
 	mustEmbedUnimplementedUnionProverAPIServer()
 }
 
@@ -121,6 +242,30 @@ func (UnimplementedUnionProverAPIServer) QueryStats(context.Context, *QueryStats
 func (UnimplementedUnionProverAPIServer) Poll(context.Context, *PollRequest) (*PollResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Poll not implemented")
 }
+func (UnimplementedUnionProverAPIServer) GetAttestation(context.Context, *GetAttestationRequest) (*GetAttestationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAttestation not implemented")
+}
+func (UnimplementedUnionProverAPIServer) CancelJob(context.Context, *CancelJobRequest) (*CancelJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelJob not implemented")
+}
+func (UnimplementedUnionProverAPIServer) GetLoad(context.Context, *GetLoadRequest) (*GetLoadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLoad not implemented")
+}
+func (UnimplementedUnionProverAPIServer) UploadInputs(context.Context, *UploadInputsRequest) (*UploadInputsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadInputs not implemented")
+}
+func (UnimplementedUnionProverAPIServer) ProveFromHandle(context.Context, *ProveFromHandleRequest) (*PollResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProveFromHandle not implemented")
+}
+func (UnimplementedUnionProverAPIServer) GetInfo(context.Context, *GetInfoRequest) (*GetInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInfo not implemented")
+}
+func (UnimplementedUnionProverAPIServer) DiffInputs(context.Context, *DiffInputsRequest) (*DiffInputsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffInputs not implemented")
+}
+func (UnimplementedUnionProverAPIServer) ProveStream(UnionProverAPI_ProveStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method ProveStream not implemented")
+}
 func (UnimplementedUnionProverAPIServer) mustEmbedUnimplementedUnionProverAPIServer() {}
 
 // UnsafeUnionProverAPIServer may be embedded to opt out of forward compatibility for this service.
@@ -224,6 +369,158 @@ func _UnionProverAPI_Poll_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UnionProverAPI_GetAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAttestationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnionProverAPIServer).GetAttestation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UnionProverAPI_GetAttestation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnionProverAPIServer).GetAttestation(ctx, req.(*GetAttestationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UnionProverAPI_CancelJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnionProverAPIServer).CancelJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UnionProverAPI_CancelJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnionProverAPIServer).CancelJob(ctx, req.(*CancelJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UnionProverAPI_GetLoad_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLoadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnionProverAPIServer).GetLoad(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UnionProverAPI_GetLoad_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnionProverAPIServer).GetLoad(ctx, req.(*GetLoadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UnionProverAPI_UploadInputs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadInputsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnionProverAPIServer).UploadInputs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UnionProverAPI_UploadInputs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnionProverAPIServer).UploadInputs(ctx, req.(*UploadInputsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UnionProverAPI_ProveFromHandle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProveFromHandleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnionProverAPIServer).ProveFromHandle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UnionProverAPI_ProveFromHandle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnionProverAPIServer).ProveFromHandle(ctx, req.(*ProveFromHandleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UnionProverAPI_GetInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnionProverAPIServer).GetInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UnionProverAPI_GetInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnionProverAPIServer).GetInfo(ctx, req.(*GetInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UnionProverAPI_DiffInputs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffInputsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnionProverAPIServer).DiffInputs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UnionProverAPI_DiffInputs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnionProverAPIServer).DiffInputs(ctx, req.(*DiffInputsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UnionProverAPI_ProveStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(UnionProverAPIServer).ProveStream(&unionProverAPIProveStreamServer{stream})
+}
+
+type UnionProverAPI_ProveStreamServer interface {
+	SendAndClose(*ProveStreamResponse) error
+	Recv() (*ProveStreamChunk, error)
+	grpc.ServerStream
+}
+
+type unionProverAPIProveStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *unionProverAPIProveStreamServer) SendAndClose(m *ProveStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *unionProverAPIProveStreamServer) Recv() (*ProveStreamChunk, error) {
+	m := new(ProveStreamChunk)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // UnionProverAPI_ServiceDesc is the grpc.ServiceDesc for UnionProverAPI service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -251,6 +548,463 @@ var UnionProverAPI_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Poll",
 			Handler:    _UnionProverAPI_Poll_Handler,
 		},
+		{
+			MethodName: "GetAttestation",
+			Handler:    _UnionProverAPI_GetAttestation_Handler,
+		},
+		{
+			MethodName: "CancelJob",
+			Handler:    _UnionProverAPI_CancelJob_Handler,
+		},
+		{
+			MethodName: "GetLoad",
+			Handler:    _UnionProverAPI_GetLoad_Handler,
+		},
+		{
+			MethodName: "UploadInputs",
+			Handler:    _UnionProverAPI_UploadInputs_Handler,
+		},
+		{
+			MethodName: "ProveFromHandle",
+			Handler:    _UnionProverAPI_ProveFromHandle_Handler,
+		},
+		{
+			MethodName: "GetInfo",
+			Handler:    _UnionProverAPI_GetInfo_Handler,
+		},
+		{
+			MethodName: "DiffInputs",
+			Handler:    _UnionProverAPI_DiffInputs_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ProveStream",
+			Handler:       _UnionProverAPI_ProveStream_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "api/v3/galois.proto",
+}
+
+const (
+	UnionProverAdminAPI_SaveSnapshot_FullMethodName        = "/union.galois.api.v3.UnionProverAdminAPI/SaveSnapshot"
+	UnionProverAdminAPI_RestoreSnapshot_FullMethodName     = "/union.galois.api.v3.UnionProverAdminAPI/RestoreSnapshot"
+	UnionProverAdminAPI_ListJobs_FullMethodName            = "/union.galois.api.v3.UnionProverAdminAPI/ListJobs"
+	UnionProverAdminAPI_GetJob_FullMethodName              = "/union.galois.api.v3.UnionProverAdminAPI/GetJob"
+	UnionProverAdminAPI_CancelJob_FullMethodName           = "/union.galois.api.v3.UnionProverAdminAPI/CancelJob"
+	UnionProverAdminAPI_ScheduleMaintenance_FullMethodName = "/union.galois.api.v3.UnionProverAdminAPI/ScheduleMaintenance"
+	UnionProverAdminAPI_CancelMaintenance_FullMethodName   = "/union.galois.api.v3.UnionProverAdminAPI/CancelMaintenance"
+	UnionProverAdminAPI_ReloadKeys_FullMethodName          = "/union.galois.api.v3.UnionProverAdminAPI/ReloadKeys"
+	UnionProverAdminAPI_SetLogLevel_FullMethodName         = "/union.galois.api.v3.UnionProverAdminAPI/SetLogLevel"
+	UnionProverAdminAPI_ListConnections_FullMethodName     = "/union.galois.api.v3.UnionProverAdminAPI/ListConnections"
+)
+
+// UnionProverAdminAPIClient is the client API for UnionProverAdminAPI service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type UnionProverAdminAPIClient interface {
+	SaveSnapshot(ctx context.Context, in *SaveSnapshotRequest, opts ...grpc.CallOption) (*SaveSnapshotResponse, error)
+	RestoreSnapshot(ctx context.Context, in *RestoreSnapshotRequest, opts ...grpc.CallOption) (*RestoreSnapshotResponse, error)
+	ListJobs(ctx context.Context, in *ListJobsRequest, opts ...grpc.CallOption) (*ListJobsResponse, error)
+	GetJob(ctx context.Context, in *GetJobRequest, opts ...grpc.CallOption) (*GetJobResponse, error)
+	CancelJob(ctx context.Context, in *CancelJobRequest, opts ...grpc.CallOption) (*CancelJobResponse, error)
+	ScheduleMaintenance(ctx context.Context, in *ScheduleMaintenanceRequest, opts ...grpc.CallOption) (*ScheduleMaintenanceResponse, error)
+	CancelMaintenance(ctx context.Context, in *CancelMaintenanceRequest, opts ...grpc.CallOption) (*CancelMaintenanceResponse, error)
+	ReloadKeys(ctx context.Context, in *ReloadKeysRequest, opts ...grpc.CallOption) (*ReloadKeysResponse, error)
+	SetLogLevel(ctx context.Context, in *SetLogLevelRequest, opts ...grpc.CallOption) (*SetLogLevelResponse, error)
+	ListConnections(ctx context.Context, in *ListConnectionsRequest, opts ...grpc.CallOption) (*ListConnectionsResponse, error)
+}
+
+type unionProverAdminAPIClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewUnionProverAdminAPIClient(cc grpc.ClientConnInterface) UnionProverAdminAPIClient {
+	return &unionProverAdminAPIClient{cc}
+}
+
+func (c *unionProverAdminAPIClient) SaveSnapshot(ctx context.Context, in *SaveSnapshotRequest, opts ...grpc.CallOption) (*SaveSnapshotResponse, error) {
+	out := new(SaveSnapshotResponse)
+	err := c.cc.Invoke(ctx, UnionProverAdminAPI_SaveSnapshot_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *unionProverAdminAPIClient) RestoreSnapshot(ctx context.Context, in *RestoreSnapshotRequest, opts ...grpc.CallOption) (*RestoreSnapshotResponse, error) {
+	out := new(RestoreSnapshotResponse)
+	err := c.cc.Invoke(ctx, UnionProverAdminAPI_RestoreSnapshot_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *unionProverAdminAPIClient) ListJobs(ctx context.Context, in *ListJobsRequest, opts ...grpc.CallOption) (*ListJobsResponse, error) {
+	out := new(ListJobsResponse)
+	err := c.cc.Invoke(ctx, UnionProverAdminAPI_ListJobs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *unionProverAdminAPIClient) GetJob(ctx context.Context, in *GetJobRequest, opts ...grpc.CallOption) (*GetJobResponse, error) {
+	out := new(GetJobResponse)
+	err := c.cc.Invoke(ctx, UnionProverAdminAPI_GetJob_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *unionProverAdminAPIClient) CancelJob(ctx context.Context, in *CancelJobRequest, opts ...grpc.CallOption) (*CancelJobResponse, error) {
+	out := new(CancelJobResponse)
+	err := c.cc.Invoke(ctx, UnionProverAdminAPI_CancelJob_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *unionProverAdminAPIClient) ScheduleMaintenance(ctx context.Context, in *ScheduleMaintenanceRequest, opts ...grpc.CallOption) (*ScheduleMaintenanceResponse, error) {
+	out := new(ScheduleMaintenanceResponse)
+	err := c.cc.Invoke(ctx, UnionProverAdminAPI_ScheduleMaintenance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *unionProverAdminAPIClient) CancelMaintenance(ctx context.Context, in *CancelMaintenanceRequest, opts ...grpc.CallOption) (*CancelMaintenanceResponse, error) {
+	out := new(CancelMaintenanceResponse)
+	err := c.cc.Invoke(ctx, UnionProverAdminAPI_CancelMaintenance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *unionProverAdminAPIClient) ReloadKeys(ctx context.Context, in *ReloadKeysRequest, opts ...grpc.CallOption) (*ReloadKeysResponse, error) {
+	out := new(ReloadKeysResponse)
+	err := c.cc.Invoke(ctx, UnionProverAdminAPI_ReloadKeys_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *unionProverAdminAPIClient) SetLogLevel(ctx context.Context, in *SetLogLevelRequest, opts ...grpc.CallOption) (*SetLogLevelResponse, error) {
+	out := new(SetLogLevelResponse)
+	err := c.cc.Invoke(ctx, UnionProverAdminAPI_SetLogLevel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *unionProverAdminAPIClient) ListConnections(ctx context.Context, in *ListConnectionsRequest, opts ...grpc.CallOption) (*ListConnectionsResponse, error) {
+	out := new(ListConnectionsResponse)
+	err := c.cc.Invoke(ctx, UnionProverAdminAPI_ListConnections_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UnionProverAdminAPIServer is the server API for UnionProverAdminAPI service.
+// All implementations must embed UnimplementedUnionProverAdminAPIServer
+// for forward compatibility
+type UnionProverAdminAPIServer interface {
+	SaveSnapshot(context.Context, *SaveSnapshotRequest) (*SaveSnapshotResponse, error)
+	RestoreSnapshot(context.Context, *RestoreSnapshotRequest) (*RestoreSnapshotResponse, error)
+	ListJobs(context.Context, *ListJobsRequest) (*ListJobsResponse, error)
+	GetJob(context.Context, *GetJobRequest) (*GetJobResponse, error)
+	CancelJob(context.Context, *CancelJobRequest) (*CancelJobResponse, error)
+	ScheduleMaintenance(context.Context, *ScheduleMaintenanceRequest) (*ScheduleMaintenanceResponse, error)
+	CancelMaintenance(context.Context, *CancelMaintenanceRequest) (*CancelMaintenanceResponse, error)
+	ReloadKeys(context.Context, *ReloadKeysRequest) (*ReloadKeysResponse, error)
+	SetLogLevel(context.Context, *SetLogLevelRequest) (*SetLogLevelResponse, error)
+	ListConnections(context.Context, *ListConnectionsRequest) (*ListConnectionsResponse, error)
+	mustEmbedUnimplementedUnionProverAdminAPIServer()
+}
+
+// UnimplementedUnionProverAdminAPIServer must be embedded to have forward compatible implementations.
+type UnimplementedUnionProverAdminAPIServer struct {
+}
+
+func (UnimplementedUnionProverAdminAPIServer) SaveSnapshot(context.Context, *SaveSnapshotRequest) (*SaveSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveSnapshot not implemented")
+}
+func (UnimplementedUnionProverAdminAPIServer) RestoreSnapshot(context.Context, *RestoreSnapshotRequest) (*RestoreSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreSnapshot not implemented")
+}
+func (UnimplementedUnionProverAdminAPIServer) ListJobs(context.Context, *ListJobsRequest) (*ListJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListJobs not implemented")
+}
+func (UnimplementedUnionProverAdminAPIServer) GetJob(context.Context, *GetJobRequest) (*GetJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJob not implemented")
+}
+func (UnimplementedUnionProverAdminAPIServer) CancelJob(context.Context, *CancelJobRequest) (*CancelJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelJob not implemented")
+}
+func (UnimplementedUnionProverAdminAPIServer) ScheduleMaintenance(context.Context, *ScheduleMaintenanceRequest) (*ScheduleMaintenanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleMaintenance not implemented")
+}
+func (UnimplementedUnionProverAdminAPIServer) CancelMaintenance(context.Context, *CancelMaintenanceRequest) (*CancelMaintenanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelMaintenance not implemented")
+}
+func (UnimplementedUnionProverAdminAPIServer) ReloadKeys(context.Context, *ReloadKeysRequest) (*ReloadKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReloadKeys not implemented")
+}
+func (UnimplementedUnionProverAdminAPIServer) SetLogLevel(context.Context, *SetLogLevelRequest) (*SetLogLevelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLogLevel not implemented")
+}
+func (UnimplementedUnionProverAdminAPIServer) ListConnections(context.Context, *ListConnectionsRequest) (*ListConnectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConnections not implemented")
+}
+func (UnimplementedUnionProverAdminAPIServer) mustEmbedUnimplementedUnionProverAdminAPIServer() {}
+
+// UnsafeUnionProverAdminAPIServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to UnionProverAdminAPIServer will
+// result in compilation errors.
+type UnsafeUnionProverAdminAPIServer interface {
+	mustEmbedUnimplementedUnionProverAdminAPIServer()
+}
+
+func RegisterUnionProverAdminAPIServer(s grpc.ServiceRegistrar, srv UnionProverAdminAPIServer) {
+	s.RegisterService(&UnionProverAdminAPI_ServiceDesc, srv)
+}
+
+func _UnionProverAdminAPI_SaveSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnionProverAdminAPIServer).SaveSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UnionProverAdminAPI_SaveSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnionProverAdminAPIServer).SaveSnapshot(ctx, req.(*SaveSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UnionProverAdminAPI_RestoreSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnionProverAdminAPIServer).RestoreSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UnionProverAdminAPI_RestoreSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnionProverAdminAPIServer).RestoreSnapshot(ctx, req.(*RestoreSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UnionProverAdminAPI_ListJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnionProverAdminAPIServer).ListJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UnionProverAdminAPI_ListJobs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnionProverAdminAPIServer).ListJobs(ctx, req.(*ListJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UnionProverAdminAPI_GetJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnionProverAdminAPIServer).GetJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UnionProverAdminAPI_GetJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnionProverAdminAPIServer).GetJob(ctx, req.(*GetJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UnionProverAdminAPI_CancelJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnionProverAdminAPIServer).CancelJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UnionProverAdminAPI_CancelJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnionProverAdminAPIServer).CancelJob(ctx, req.(*CancelJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UnionProverAdminAPI_ScheduleMaintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleMaintenanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnionProverAdminAPIServer).ScheduleMaintenance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UnionProverAdminAPI_ScheduleMaintenance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnionProverAdminAPIServer).ScheduleMaintenance(ctx, req.(*ScheduleMaintenanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UnionProverAdminAPI_CancelMaintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelMaintenanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnionProverAdminAPIServer).CancelMaintenance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UnionProverAdminAPI_CancelMaintenance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnionProverAdminAPIServer).CancelMaintenance(ctx, req.(*CancelMaintenanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UnionProverAdminAPI_ReloadKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReloadKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnionProverAdminAPIServer).ReloadKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UnionProverAdminAPI_ReloadKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnionProverAdminAPIServer).ReloadKeys(ctx, req.(*ReloadKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UnionProverAdminAPI_SetLogLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLogLevelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnionProverAdminAPIServer).SetLogLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UnionProverAdminAPI_SetLogLevel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnionProverAdminAPIServer).SetLogLevel(ctx, req.(*SetLogLevelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UnionProverAdminAPI_ListConnections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConnectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnionProverAdminAPIServer).ListConnections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UnionProverAdminAPI_ListConnections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnionProverAdminAPIServer).ListConnections(ctx, req.(*ListConnectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// UnionProverAdminAPI_ServiceDesc is the grpc.ServiceDesc for UnionProverAdminAPI service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var UnionProverAdminAPI_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "union.galois.api.v3.UnionProverAdminAPI",
+	HandlerType: (*UnionProverAdminAPIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SaveSnapshot",
+			Handler:    _UnionProverAdminAPI_SaveSnapshot_Handler,
+		},
+		{
+			MethodName: "RestoreSnapshot",
+			Handler:    _UnionProverAdminAPI_RestoreSnapshot_Handler,
+		},
+		{
+			MethodName: "ListJobs",
+			Handler:    _UnionProverAdminAPI_ListJobs_Handler,
+		},
+		{
+			MethodName: "GetJob",
+			Handler:    _UnionProverAdminAPI_GetJob_Handler,
+		},
+		{
+			MethodName: "CancelJob",
+			Handler:    _UnionProverAdminAPI_CancelJob_Handler,
+		},
+		{
+			MethodName: "ScheduleMaintenance",
+			Handler:    _UnionProverAdminAPI_ScheduleMaintenance_Handler,
+		},
+		{
+			MethodName: "CancelMaintenance",
+			Handler:    _UnionProverAdminAPI_CancelMaintenance_Handler,
+		},
+		{
+			MethodName: "ReloadKeys",
+			Handler:    _UnionProverAdminAPI_ReloadKeys_Handler,
+		},
+		{
+			MethodName: "SetLogLevel",
+			Handler:    _UnionProverAdminAPI_SetLogLevel_Handler,
+		},
+		{
+			MethodName: "ListConnections",
+			Handler:    _UnionProverAdminAPI_ListConnections_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v3/galois.proto",
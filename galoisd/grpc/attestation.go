@@ -0,0 +1,91 @@
+package grpc
+
+import (
+	context "context"
+	"crypto/sha256"
+	"fmt"
+	grpc "galois/grpc/api/v3"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Pseudo filesystem exposed by Gramine to SGX enclaves. Writing the report data
+// and then reading the quote yields a quote binding the data to the enclave
+// measurement.
+const AttestationDir = "/dev/attestation"
+
+// The report data is shared by the whole enclave, a quote must be read before
+// another caller writes its own data.
+var attestationMu sync.Mutex
+
+const reportDataSize = 64
+
+// Hash the in-memory circuit and verifying key; computed once as serializing the
+// constraint system is expensive.
+func (p *proverServer) artifactHashes() ([]byte, []byte, error) {
+	p.hashesOnce.Do(func() {
+		keys := p.current.Load()
+		h := sha256.New()
+		if _, err := keys.cs.WriteTo(h); err != nil {
+			p.hashesErr = fmt.Errorf("Could not hash the circuit %s", err)
+			return
+		}
+		p.circuitHash = h.Sum(nil)
+		h.Reset()
+		if _, err := keys.vk.WriteTo(h); err != nil {
+			p.hashesErr = fmt.Errorf("Could not hash the verifying key %s", err)
+			return
+		}
+		p.vkHash = h.Sum(nil)
+	})
+	return p.circuitHash, p.vkHash, p.hashesErr
+}
+
+func readQuote(dir string, reportData []byte) ([]byte, error) {
+	if _, err := os.Stat(dir); err != nil {
+		return nil, fmt.Errorf("Not running inside an enclave, %s is not available", dir)
+	}
+	attestationMu.Lock()
+	defer attestationMu.Unlock()
+	err := os.WriteFile(filepath.Join(dir, "user_report_data"), reportData, 0)
+	if err != nil {
+		return nil, fmt.Errorf("Could not write the report data %s", err)
+	}
+	quote, err := os.ReadFile(filepath.Join(dir, "quote"))
+	if err != nil {
+		return nil, fmt.Errorf("Could not read the quote %s", err)
+	}
+	return quote, nil
+}
+
+func (p *proverServer) GetAttestation(ctx context.Context, req *grpc.GetAttestationRequest) (*grpc.GetAttestationResponse, error) {
+	log.Debug().Msg("Attesting...")
+
+	circuitHash, vkHash, err := p.artifactHashes()
+	if err != nil {
+		return nil, err
+	}
+
+	// report_data = sha256(circuit_hash || vk_hash || nonce) || 0^32
+	h := sha256.New()
+	h.Write(circuitHash)
+	h.Write(vkHash)
+	h.Write(req.Nonce)
+	reportData := make([]byte, reportDataSize)
+	copy(reportData, h.Sum(nil))
+
+	quote, err := readQuote(AttestationDir, reportData)
+	if err != nil {
+		return nil, err
+	}
+
+	return &grpc.GetAttestationResponse{
+		CircuitHash:      circuitHash,
+		VerifyingKeyHash: vkHash,
+		ReportData:       reportData,
+		Quote:            quote,
+	}, nil
+}
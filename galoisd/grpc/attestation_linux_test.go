@@ -0,0 +1,106 @@
+package grpc
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Emulates the Gramine pseudo filesystem: the quote is a pipe serving the
+// report data written last, once new report data was written.
+func fakeAttestationDir(t *testing.T) string {
+	dir := t.TempDir()
+	reportDataPath := filepath.Join(dir, "user_report_data")
+	quotePath := filepath.Join(dir, "quote")
+	require.NoError(t, os.WriteFile(reportDataPath, nil, 0600))
+	require.NoError(t, syscall.Mkfifo(quotePath, 0600))
+	done := make(chan struct{})
+	served := make(chan struct{})
+	go func() {
+		defer close(served)
+		var last []byte
+		for {
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+				reportData, _ := os.ReadFile(reportDataPath)
+				if len(reportData) == reportDataSize && !bytes.Equal(reportData, last) {
+					break
+				}
+				time.Sleep(50 * time.Microsecond)
+			}
+			quote, err := os.OpenFile(quotePath, os.O_WRONLY, 0)
+			if err != nil {
+				return
+			}
+			select {
+			case <-done:
+				quote.Close()
+				return
+			default:
+			}
+			// The quote binds whatever report data is current when generated
+			last, _ = os.ReadFile(reportDataPath)
+			quote.Write(append([]byte("quote:"), last...))
+			quote.Close()
+		}
+	}()
+	t.Cleanup(func() {
+		close(done)
+		// Unblocks the writer waiting for a reader
+		f, err := os.OpenFile(quotePath, os.O_RDONLY|syscall.O_NONBLOCK, 0)
+		<-served
+		if err == nil {
+			f.Close()
+		}
+	})
+	return dir
+}
+
+func TestConcurrentQuotesAreBoundToTheirReportData(t *testing.T) {
+	dir := fakeAttestationDir(t)
+	const callers = 8
+	const calls = 50
+	var wg sync.WaitGroup
+	errs := make(chan error, callers)
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < calls; j++ {
+				reportData := make([]byte, reportDataSize)
+				reportData[0], reportData[1] = byte(i), byte(j)
+				quote, err := readQuote(dir, reportData)
+				if err != nil {
+					errs <- err
+					return
+				}
+				if !bytes.Equal(quote, append([]byte("quote:"), reportData...)) {
+					errs <- errors.New("quote bound to the report data of another caller")
+					return
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		assert.NoError(t, err)
+	}
+}
+
+func TestQuoteOutsideEnclave(t *testing.T) {
+	_, err := readQuote(filepath.Join(t.TempDir(), "missing"), make([]byte, reportDataSize))
+	assert.Error(t, err)
+}
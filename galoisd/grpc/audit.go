@@ -0,0 +1,216 @@
+package grpc
+
+import (
+	"bytes"
+	context "context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	grpc "galois/grpc/api/v3"
+	"galois/pkg/storage"
+	"galois/pkg/verify"
+	"math/rand/v2"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/rs/zerolog/log"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// Health service reporting the proof audits, NOT_SERVING while the last audit
+// found a corrupted proof.
+const ProofsHealthService = "galoisd.proofs"
+
+var proofAuditsCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "galoisd",
+	Subsystem: "prover",
+	Name:      "proof_audits_total",
+	Help:      "Number of cached or persisted proofs verified again in the background, by source and result.",
+}, []string{"source", "result"})
+
+// Background verification of the proofs already generated, catching the
+// corruption of the memory or disk before a relayer submits a bad proof.
+type audit struct {
+	interval time.Duration
+	// Proofs verified per source and round
+	sample int
+}
+
+// WithProofAudit verifies, every interval, a random sample of the cached
+// results and of the persisted proofs against the verifying key. A corrupted
+// cached result is evicted, such that the request is proven again.
+func WithProofAudit(interval time.Duration, sample int) Option {
+	return func(p *proverServer) {
+		p.audit = &audit{interval: interval, sample: sample}
+	}
+}
+
+// Verify a proof against the keys of its circuit.
+func (p *proverServer) verifyProof(circuitID []byte, proof *grpc.ZeroKnowledgeProof, inputsHash []byte) error {
+	keys, err := p.keysFor(circuitID)
+	if err != nil {
+		return err
+	}
+	if proof == nil {
+		return fmt.Errorf("Missing proof")
+	}
+	decoded, err := verify.ReadProof(bytes.NewReader(proof.CompressedContent))
+	if err != nil {
+		return fmt.Errorf("Could not read compressed proof %s", err)
+	}
+	var input fr.Element
+	input.SetBytes(inputsHash)
+	return verify.Verify(keys.verifier, decoded, []fr.Element{input})
+}
+
+type cachedProof struct {
+	proveKey   [32]byte
+	res        *grpc.ProveResponse
+	circuitID  []byte
+	inputsHash []byte
+}
+
+// Verify a sample of the cached results, returning the number of corrupted
+// ones.
+func (p *proverServer) auditResults() int {
+	var cached []cachedProof
+	p.results.Range(func(key, value any) bool {
+		res, done := value.(*grpc.ProveResponse)
+		if !done {
+			return true
+		}
+		proof := cachedProof{proveKey: key.([32]byte), res: res}
+		p.updateJob(proof.proveKey, func(j *job) {
+			proof.circuitID, proof.inputsHash = j.circuitID, j.inputsHash
+		})
+		// The inputs of the restored results are unknown
+		if proof.inputsHash != nil {
+			cached = append(cached, proof)
+		}
+		return true
+	})
+	rand.Shuffle(len(cached), func(i, j int) { cached[i], cached[j] = cached[j], cached[i] })
+
+	corrupted := 0
+	for _, proof := range cached[:min(len(cached), p.audit.sample)] {
+		if _, err := p.keysFor(proof.circuitID); err != nil {
+			proofAuditsCounter.WithLabelValues("memory", "skipped").Inc()
+			continue
+		}
+		if err := p.verifyProof(proof.circuitID, proof.res.Proof, proof.inputsHash); err != nil {
+			corrupted++
+			proofAuditsCounter.WithLabelValues("memory", "invalid").Inc()
+			log.Error().Hex("request_hash", proof.proveKey[:]).Err(err).Msg("Cached proof failed verification, evicting it")
+			if p.results.CompareAndDelete(proof.proveKey, proof.res) {
+				p.jobs.Delete(proof.proveKey)
+			}
+			continue
+		}
+		proofAuditsCounter.WithLabelValues("memory", "valid").Inc()
+	}
+	return corrupted
+}
+
+// Verify a sample of the persisted proofs, returning the number of corrupted
+// ones. They are left in place for investigation.
+func (p *proverServer) auditPersistedProofs(ctx context.Context) int {
+	prefix := p.proofOutputDir
+	if prefix != "" && !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+	keys, err := p.storage.List(ctx, prefix)
+	if err != nil {
+		log.Warn().Str("path", p.proofOutputDir).Err(err).Msg("Could not list persisted proofs")
+		return 0
+	}
+	var proofs []string
+	for _, key := range keys {
+		if path.Ext(key) == ".json" {
+			proofs = append(proofs, key)
+		}
+	}
+	rand.Shuffle(len(proofs), func(i, j int) { proofs[i], proofs[j] = proofs[j], proofs[i] })
+
+	corrupted := 0
+	for _, key := range proofs[:min(len(proofs), p.audit.sample)] {
+		bz, err := storage.ReadAll(ctx, p.storage, key)
+		if err != nil {
+			// Possibly evicted or rotated in the meantime
+			proofAuditsCounter.WithLabelValues("storage", "error").Inc()
+			log.Warn().Str("path", key).Err(err).Msg("Could not read persisted proof")
+			continue
+		}
+		var persisted PersistedProof
+		if err = json.Unmarshal(bz, &persisted); err == nil {
+			err = p.checkPersistedProof(&persisted)
+		}
+		if err == errUnknownCircuit {
+			proofAuditsCounter.WithLabelValues("storage", "skipped").Inc()
+			continue
+		}
+		if err != nil {
+			corrupted++
+			proofAuditsCounter.WithLabelValues("storage", "invalid").Inc()
+			log.Error().Str("path", key).Err(err).Msg("Persisted proof failed verification")
+			continue
+		}
+		proofAuditsCounter.WithLabelValues("storage", "valid").Inc()
+	}
+	return corrupted
+}
+
+// Returned for the proofs of a circuit no longer served, that can't be audited.
+var errUnknownCircuit = errors.New("unknown circuit")
+
+func (p *proverServer) checkPersistedProof(persisted *PersistedProof) error {
+	req, res := persisted.Request, persisted.Response
+	if req == nil || req.UntrustedHeader == nil || res == nil {
+		return fmt.Errorf("Missing request or response")
+	}
+	if _, err := p.keysFor(req.CircuitId); err != nil {
+		return errUnknownCircuit
+	}
+	// The hash binds the proof to the persisted request
+	inputsHash := InputsHash(req.GetVote().GetChainID(), req.UntrustedHeader, res.TrustedValidatorSetRoot)
+	if !bytes.Equal(inputsHash, persisted.InputsHash) {
+		return fmt.Errorf("Inputs hash %x doesn't match the persisted request, expected %x", persisted.InputsHash, inputsHash)
+	}
+	return p.verifyProof(req.CircuitId, res.Proof, inputsHash)
+}
+
+func (p *proverServer) auditProofs(wasHealthy bool) bool {
+	corrupted := p.auditResults()
+	if p.proofOutputDir != "" {
+		corrupted += p.auditPersistedProofs(context.Background())
+	}
+	healthy := corrupted == 0
+	if p.health != nil {
+		healthStatus := healthpb.HealthCheckResponse_SERVING
+		if !healthy {
+			healthStatus = healthpb.HealthCheckResponse_NOT_SERVING
+		}
+		p.health.SetServingStatus(ProofsHealthService, healthStatus)
+	}
+	if healthy != wasHealthy {
+		event := log.Warn()
+		if healthy {
+			event = log.Info()
+		}
+		event.Int("corrupted", corrupted).Msg("Proofs audited")
+	}
+	return healthy
+}
+
+func (p *proverServer) watchProofs() {
+	healthy := true
+	if p.health != nil {
+		p.health.SetServingStatus(ProofsHealthService, healthpb.HealthCheckResponse_SERVING)
+	}
+	for range time.Tick(p.audit.interval) {
+		healthy = p.auditProofs(healthy)
+	}
+}
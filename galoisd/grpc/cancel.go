@@ -0,0 +1,126 @@
+package grpc
+
+import (
+	context "context"
+	grpc "galois/grpc/api/v3"
+	"galois/pkg/apierror"
+	"math/big"
+	"net"
+	"strings"
+
+	"github.com/consensys/gnark/constraint/solver"
+	"github.com/rs/zerolog/log"
+	ggrpc "google.golang.org/grpc"
+)
+
+var errJobCancelled = apierror.New(apierror.ErrCancelled, "job cancelled")
+
+// gnark doesn't take a context, the only way to interrupt the solver is to make
+// one of the hints of the circuit fail. The emulated arithmetic calls hints all
+// along the solving so the job stops shortly after being cancelled. The MSMs
+// computed once solved can't be interrupted.
+func cancellableHints(ctx context.Context) []solver.Option {
+	hints := solver.GetRegisteredHints()
+	opts := make([]solver.Option, 0, len(hints))
+	for _, hint := range hints {
+		hint := hint
+		opts = append(opts, solver.OverrideHint(solver.GetHintID(hint), func(field *big.Int, inputs []*big.Int, outputs []*big.Int) error {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			return hint(field, inputs, outputs)
+		}))
+	}
+	return opts
+}
+
+// Whether the call comes from the admin service, restricted by the operator.
+func isAdminCall(ctx context.Context) bool {
+	method, ok := ggrpc.Method(ctx)
+	return ok && strings.HasPrefix(method, "/"+grpc.UnionProverAdminAPI_ServiceDesc.ServiceName+"/")
+}
+
+// Authenticated owners are identified by their principal. Otherwise, clients
+// reconnect from different ports, only the host identifies the submitter, which
+// is shared by the clients behind the same host or NAT.
+func sameOwner(owner string, ownerAuthenticated bool, caller string, callerAuthenticated bool) bool {
+	if owner == "" || caller == "" {
+		return false
+	}
+	if ownerAuthenticated || callerAuthenticated {
+		return ownerAuthenticated && callerAuthenticated && owner == caller
+	}
+	hostA, _, err := net.SplitHostPort(owner)
+	if err != nil {
+		hostA = owner
+	}
+	hostB, _, err := net.SplitHostPort(caller)
+	if err != nil {
+		hostB = caller
+	}
+	return hostA == hostB
+}
+
+// Exposed on both the public and admin services. Public callers can only cancel
+// the jobs they submitted, identified by the request hash in their namespace,
+// while the admin service takes the keys listed by ListJobs.
+func (p *proverServer) CancelJob(ctx context.Context, req *grpc.CancelJobRequest) (*grpc.CancelJobResponse, error) {
+	log.Debug().Hex("request_hash", req.RequestHash).Msg("Cancelling job...")
+
+	var proveKey [32]byte
+	if len(req.RequestHash) != len(proveKey) {
+		return nil, apierror.New(apierror.ErrInvalidRequest, "Invalid request hash, expected %d bytes", len(proveKey))
+	}
+	copy(proveKey[:], req.RequestHash)
+	admin := isAdminCall(ctx)
+	if !admin {
+		proveKey = tenantKey(tenantFromContext(ctx), proveKey)
+	}
+	result, found := p.results.Load(proveKey)
+	if !found {
+		return nil, apierror.New(apierror.ErrUnknownJob, "Unknown job %x", req.RequestHash)
+	}
+
+	if !admin {
+		var owner string
+		var authenticated bool
+		if value, found := p.jobs.Load(proveKey); found {
+			j := value.(*job)
+			j.mu.Lock()
+			owner, authenticated = j.owner, j.authenticated
+			j.mu.Unlock()
+		}
+		caller, callerAuthenticated := callerFromContext(ctx)
+		if !sameOwner(owner, authenticated, caller, callerAuthenticated) {
+			return nil, apierror.New(apierror.ErrNotOwner, "Not authorized to cancel job %x", proveKey)
+		}
+	}
+
+	if _, pending := result.(*grpc.ProveRequestPending); !pending {
+		return nil, apierror.New(apierror.ErrJobCompleted, "Job %x is already completed", proveKey)
+	}
+	if p.dequeue(proveKey) {
+		p.releaseTenantJob(proveKey)
+		p.results.Store(proveKey, errJobCancelled)
+		log.Info().Hex("request_hash", proveKey[:]).Str("owner", ownerFromContext(ctx)).Msg("cancel")
+		return p.cancelResponse(req, proveKey, errJobCancelled), nil
+	}
+	cancel, found := p.cancels.Load(proveKey)
+	if !found {
+		return nil, apierror.New(apierror.ErrJobAdmitting, "Job %x is being admitted, retry later", proveKey)
+	}
+	cancel.(context.CancelFunc)()
+
+	log.Info().Hex("request_hash", proveKey[:]).Str("owner", ownerFromContext(ctx)).Msg("cancel")
+
+	return p.cancelResponse(req, proveKey, result), nil
+}
+
+// Public callers get the job under the hash they know it by.
+func (p *proverServer) cancelResponse(req *grpc.CancelJobRequest, proveKey [32]byte, result any) *grpc.CancelJobResponse {
+	job := p.describeJob(proveKey, result)
+	job.RequestHash = req.RequestHash
+	return &grpc.CancelJobResponse{
+		Job: job,
+	}
+}
@@ -0,0 +1,25 @@
+package grpc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSameOwner(t *testing.T) {
+	// Unauthenticated clients are identified by host, whatever their port
+	assert.True(t, sameOwner("10.0.0.1:4000", false, "10.0.0.1:5000", false))
+	assert.False(t, sameOwner("10.0.0.1:4000", false, "10.0.0.2:4000", false))
+	assert.True(t, sameOwner("[::1]:4000", false, "[::1]:5000", false))
+
+	// Principals are compared as is
+	assert.True(t, sameOwner("relayer-a", true, "relayer-a", true))
+	assert.False(t, sameOwner("relayer-a", true, "relayer-b", true))
+
+	// An address never matches a principal, even a principal named after it
+	assert.False(t, sameOwner("relayer-a", true, "10.0.0.1:4000", false))
+	assert.False(t, sameOwner("10.0.0.1", false, "10.0.0.1", true))
+
+	assert.False(t, sameOwner("", false, "", false))
+	assert.False(t, sameOwner(snapshotOwner, false, "10.0.0.1:4000", false))
+}
@@ -0,0 +1,161 @@
+package client
+
+import (
+	context "context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	grpc "galois/grpc/api/v3"
+	"time"
+)
+
+const (
+	// Delay between two polls of a pending request by default.
+	DefaultPollInterval = time.Second
+	// Time given to the losing provers to acknowledge the cancellation.
+	cancelTimeout = 5 * time.Second
+)
+
+// Hash identifying a request on the provers, as computed when it is polled.
+func RequestHash(req *grpc.ProveRequest) ([]byte, error) {
+	reqJson, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("Could not marshal request %s", err)
+	}
+	hash := sha256.Sum256(reqJson)
+	return hash[:], nil
+}
+
+// How a request is raced across the provers.
+type HedgeOptions struct {
+	// Delay after which the request is also submitted to the next prover if
+	// none succeeded, 0 submitting it to all the provers at once. A prover
+	// failing hedges the request to the next one right away.
+	Delay time.Duration
+	// Maximum number of provers the request is submitted to, all if 0.
+	MaxProvers int
+	// Zero picks DefaultPollInterval.
+	PollInterval time.Duration
+}
+
+// HedgedClient proves requests on several provers, e.g. in different regions,
+// returning the first proof and cancelling the jobs of the other provers. It
+// trades proving capacity for latency and is meant for the few latency-critical
+// requests, the others being submitted to a single prover.
+type HedgedClient struct {
+	provers []grpc.UnionProverAPIClient
+}
+
+// The provers are tried in order, the preferred one first.
+func NewHedgedClient(provers ...grpc.UnionProverAPIClient) *HedgedClient {
+	return &HedgedClient{provers: provers}
+}
+
+type attempt struct {
+	prover int
+	res    *grpc.ProveResponse
+	err    error
+}
+
+// Poll a request on a prover until its proof is done.
+func (c *HedgedClient) poll(ctx context.Context, prover int, req *grpc.ProveRequest, pollInterval time.Duration) (*grpc.ProveResponse, error) {
+	for {
+		res, err := c.provers[prover].Poll(ctx, &grpc.PollRequest{Request: req})
+		if err != nil {
+			return nil, err
+		}
+		switch result := res.Result.(type) {
+		case *grpc.PollResponse_Done:
+			return result.Done.Response, nil
+		case *grpc.PollResponse_Failed:
+			return nil, errors.New(result.Failed.Message)
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+// Cancel the job on the provers other than the winner, without waiting for
+// them. The provers that never accepted the job reject the cancellation.
+func (c *HedgedClient) cancelJobs(ctx context.Context, requestHash []byte, started int, winner int) {
+	for i := 0; i < started; i++ {
+		if i == winner {
+			continue
+		}
+		go func(prover grpc.UnionProverAPIClient) {
+			ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), cancelTimeout)
+			defer cancel()
+			prover.CancelJob(ctx, &grpc.CancelJobRequest{RequestHash: requestHash})
+		}(c.provers[i])
+	}
+}
+
+// Prove a request, returning the first proof along with the index of the
+// prover that generated it.
+func (c *HedgedClient) Prove(ctx context.Context, req *grpc.ProveRequest, opts HedgeOptions) (*grpc.ProveResponse, int, error) {
+	nbProvers := len(c.provers)
+	if opts.MaxProvers > 0 {
+		nbProvers = min(nbProvers, opts.MaxProvers)
+	}
+	if nbProvers == 0 {
+		return nil, -1, fmt.Errorf("No prover to submit the request to")
+	}
+	pollInterval := opts.PollInterval
+	if pollInterval <= 0 {
+		pollInterval = DefaultPollInterval
+	}
+	requestHash, err := RequestHash(req)
+	if err != nil {
+		return nil, -1, err
+	}
+
+	attemptCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	attempts := make(chan attempt, nbProvers)
+	started := 0
+	start := func() {
+		go func(prover int) {
+			res, err := c.poll(attemptCtx, prover, req, pollInterval)
+			attempts <- attempt{prover, res, err}
+		}(started)
+		started++
+	}
+	start()
+	for opts.Delay <= 0 && started < nbProvers {
+		start()
+	}
+	hedge := time.NewTimer(opts.Delay)
+	defer hedge.Stop()
+
+	var errs []error
+	for finished := 0; finished < started; {
+		select {
+		case <-hedge.C:
+			if started < nbProvers {
+				start()
+				hedge.Reset(opts.Delay)
+			}
+		case a := <-attempts:
+			finished++
+			if a.err == nil {
+				cancel()
+				c.cancelJobs(ctx, requestHash, started, a.prover)
+				return a.res, a.prover, nil
+			}
+			errs = append(errs, fmt.Errorf("prover %d: %w", a.prover, a.err))
+			if ctx.Err() == nil && started < nbProvers {
+				start()
+				hedge.Reset(opts.Delay)
+			}
+		}
+	}
+	if ctx.Err() != nil {
+		// The jobs may still be pending on the provers
+		c.cancelJobs(ctx, requestHash, started, -1)
+	}
+	return nil, -1, errors.Join(errs...)
+}
@@ -0,0 +1,141 @@
+package client
+
+import (
+	context "context"
+	"errors"
+	grpc "galois/grpc/api/v3"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	ggrpc "google.golang.org/grpc"
+)
+
+// Prover answering the polls with poll, the other methods being unimplemented.
+type fakeProver struct {
+	grpc.UnionProverAPIClient
+	poll      func(ctx context.Context) (*grpc.PollResponse, error)
+	polls     atomic.Int32
+	cancelled chan []byte
+}
+
+func newFakeProver(poll func(ctx context.Context) (*grpc.PollResponse, error)) *fakeProver {
+	return &fakeProver{poll: poll, cancelled: make(chan []byte, 1)}
+}
+
+func (f *fakeProver) Poll(ctx context.Context, in *grpc.PollRequest, opts ...ggrpc.CallOption) (*grpc.PollResponse, error) {
+	f.polls.Add(1)
+	return f.poll(ctx)
+}
+
+func (f *fakeProver) CancelJob(ctx context.Context, in *grpc.CancelJobRequest, opts ...ggrpc.CallOption) (*grpc.CancelJobResponse, error) {
+	f.cancelled <- in.RequestHash
+	return &grpc.CancelJobResponse{}, nil
+}
+
+func pending(ctx context.Context) (*grpc.PollResponse, error) {
+	return &grpc.PollResponse{
+		Result: &grpc.PollResponse_Pending{Pending: &grpc.ProveRequestPending{}},
+	}, nil
+}
+
+func done(proof []byte) func(ctx context.Context) (*grpc.PollResponse, error) {
+	return func(ctx context.Context) (*grpc.PollResponse, error) {
+		return &grpc.PollResponse{
+			Result: &grpc.PollResponse_Done{Done: &grpc.ProveRequestDone{
+				Response: &grpc.ProveResponse{Proof: &grpc.ZeroKnowledgeProof{Content: proof}},
+			}},
+		}, nil
+	}
+}
+
+func failing(ctx context.Context) (*grpc.PollResponse, error) {
+	return nil, errors.New("busy_building")
+}
+
+func hedgedClient(provers ...*fakeProver) *HedgedClient {
+	clients := make([]grpc.UnionProverAPIClient, len(provers))
+	for i, prover := range provers {
+		clients[i] = prover
+	}
+	return NewHedgedClient(clients...)
+}
+
+func TestHedgeFirstProverWinsAndCancelsOthers(t *testing.T) {
+	provers := []*fakeProver{newFakeProver(pending), newFakeProver(done([]byte("proof"))), newFakeProver(pending)}
+	req := &grpc.ProveRequest{CircuitId: []byte("circuit")}
+
+	res, winner, err := hedgedClient(provers...).Prove(context.Background(), req, HedgeOptions{PollInterval: time.Millisecond})
+	require.NoError(t, err)
+	assert.Equal(t, 1, winner)
+	assert.Equal(t, []byte("proof"), res.Proof.Content)
+
+	requestHash, err := RequestHash(req)
+	require.NoError(t, err)
+	for _, i := range []int{0, 2} {
+		select {
+		case hash := <-provers[i].cancelled:
+			assert.Equal(t, requestHash, hash)
+		case <-time.After(time.Second):
+			t.Fatalf("The job of prover %d was not cancelled", i)
+		}
+	}
+	select {
+	case <-provers[1].cancelled:
+		t.Fatal("The job of the winner was cancelled")
+	case <-time.After(10 * time.Millisecond):
+	}
+}
+
+func TestHedgeFailsOverOnError(t *testing.T) {
+	provers := []*fakeProver{newFakeProver(failing), newFakeProver(done([]byte("proof")))}
+
+	// The failure hedges the request right away, not after the delay
+	start := time.Now()
+	_, winner, err := hedgedClient(provers...).Prove(context.Background(), &grpc.ProveRequest{}, HedgeOptions{
+		Delay:        time.Hour,
+		PollInterval: time.Millisecond,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, winner)
+	assert.Less(t, time.Since(start), time.Minute)
+}
+
+func TestHedgeWithoutDelayRacesAllProvers(t *testing.T) {
+	// Each prover answers once all of them were polled, which only happens if
+	// they are raced at once
+	var started sync.WaitGroup
+	started.Add(3)
+	barrier := func(poll func(ctx context.Context) (*grpc.PollResponse, error)) func(ctx context.Context) (*grpc.PollResponse, error) {
+		var once sync.Once
+		return func(ctx context.Context) (*grpc.PollResponse, error) {
+			once.Do(started.Done)
+			started.Wait()
+			return poll(ctx)
+		}
+	}
+	provers := []*fakeProver{newFakeProver(barrier(pending)), newFakeProver(barrier(pending)), newFakeProver(barrier(done([]byte("proof"))))}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	_, winner, err := hedgedClient(provers...).Prove(ctx, &grpc.ProveRequest{}, HedgeOptions{PollInterval: time.Millisecond})
+	require.NoError(t, err)
+	assert.Equal(t, 2, winner)
+}
+
+func TestHedgeMaxProvers(t *testing.T) {
+	provers := []*fakeProver{newFakeProver(failing), newFakeProver(failing), newFakeProver(done([]byte("proof")))}
+
+	_, winner, err := hedgedClient(provers...).Prove(context.Background(), &grpc.ProveRequest{}, HedgeOptions{
+		MaxProvers:   2,
+		PollInterval: time.Millisecond,
+	})
+	require.Error(t, err)
+	assert.Equal(t, -1, winner)
+	assert.Equal(t, int32(1), provers[0].polls.Load())
+	assert.Equal(t, int32(1), provers[1].polls.Load())
+	assert.Zero(t, provers[2].polls.Load())
+}
@@ -0,0 +1,117 @@
+// Package client helps relayers drive a prover beyond the generated gRPC
+// client.
+package client
+
+import (
+	context "context"
+	"fmt"
+	grpc "galois/grpc/api/v3"
+
+	types "github.com/cometbft/cometbft/api/cometbft/types/v1"
+)
+
+// Number of validators, or signatures, sent per message by default.
+const DefaultChunkSize = 16
+
+// ProveStream submits a prove request while it is being built. Validators and
+// signatures are sent in chunks as they are appended, such that the relayer
+// never materializes the whole request. The trusted and untrusted sets may be
+// interleaved, the order within each set is preserved.
+type ProveStream struct {
+	stream    grpc.UnionProverAPI_ProveStreamClient
+	chunkSize int
+	trusted   grpc.ValidatorsChunk
+	untrusted grpc.ValidatorsChunk
+}
+
+// Open a stream and send the header of the request, the bitmaps of the commits
+// being part of it.
+func NewProveStream(ctx context.Context, client grpc.UnionProverAPIClient, header *grpc.ProveStreamHeader, chunkSize int) (*ProveStream, error) {
+	if chunkSize <= 0 {
+		chunkSize = DefaultChunkSize
+	}
+	stream, err := client.ProveStream(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("Could not open prove stream %s", err)
+	}
+	err = stream.Send(&grpc.ProveStreamChunk{
+		Chunk: &grpc.ProveStreamChunk_Header{Header: header},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Could not send header %s", err)
+	}
+	return &ProveStream{
+		stream:    stream,
+		chunkSize: chunkSize,
+	}, nil
+}
+
+func (s *ProveStream) flushTrusted() error {
+	if len(s.trusted.Validators) == 0 && len(s.trusted.Signatures) == 0 {
+		return nil
+	}
+	err := s.stream.Send(&grpc.ProveStreamChunk{
+		Chunk: &grpc.ProveStreamChunk_Trusted{Trusted: &s.trusted},
+	})
+	s.trusted = grpc.ValidatorsChunk{}
+	return err
+}
+
+func (s *ProveStream) flushUntrusted() error {
+	if len(s.untrusted.Validators) == 0 && len(s.untrusted.Signatures) == 0 {
+		return nil
+	}
+	err := s.stream.Send(&grpc.ProveStreamChunk{
+		Chunk: &grpc.ProveStreamChunk_Untrusted{Untrusted: &s.untrusted},
+	})
+	s.untrusted = grpc.ValidatorsChunk{}
+	return err
+}
+
+func (s *ProveStream) full(chunk *grpc.ValidatorsChunk) bool {
+	return len(chunk.Validators) >= s.chunkSize || len(chunk.Signatures) >= s.chunkSize
+}
+
+func (s *ProveStream) AddTrustedValidator(validator *types.SimpleValidator) error {
+	s.trusted.Validators = append(s.trusted.Validators, validator)
+	if s.full(&s.trusted) {
+		return s.flushTrusted()
+	}
+	return nil
+}
+
+func (s *ProveStream) AddTrustedSignature(signature []byte) error {
+	s.trusted.Signatures = append(s.trusted.Signatures, signature)
+	if s.full(&s.trusted) {
+		return s.flushTrusted()
+	}
+	return nil
+}
+
+func (s *ProveStream) AddUntrustedValidator(validator *types.SimpleValidator) error {
+	s.untrusted.Validators = append(s.untrusted.Validators, validator)
+	if s.full(&s.untrusted) {
+		return s.flushUntrusted()
+	}
+	return nil
+}
+
+func (s *ProveStream) AddUntrustedSignature(signature []byte) error {
+	s.untrusted.Signatures = append(s.untrusted.Signatures, signature)
+	if s.full(&s.untrusted) {
+		return s.flushUntrusted()
+	}
+	return nil
+}
+
+// Send the remaining chunks and submit the request. The returned handle is then
+// polled with ProveFromHandle until the proof is done.
+func (s *ProveStream) Submit() (*grpc.ProveStreamResponse, error) {
+	if err := s.flushTrusted(); err != nil {
+		return nil, err
+	}
+	if err := s.flushUntrusted(); err != nil {
+		return nil, err
+	}
+	return s.stream.CloseAndRecv()
+}
@@ -0,0 +1,99 @@
+package grpc
+
+import (
+	context "context"
+	"galois/pkg/clockskew"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/rs/zerolog/log"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// Health service reporting the clock, NOT_SERVING while it is skewed such that
+// orchestrators can act on it without taking the prover out of rotation.
+const ClockHealthService = "galoisd.clock"
+
+var (
+	clockOffsetGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "galoisd",
+		Subsystem: "clock",
+		Name:      "offset_seconds",
+		Help:      "Median offset of the NTP sources against the local clock, positive when the local clock is behind.",
+	})
+	clockReachableGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "galoisd",
+		Subsystem: "clock",
+		Name:      "reachable_sources",
+		Help:      "Number of NTP sources that answered the last check.",
+	})
+	clockHealthyGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "galoisd",
+		Subsystem: "clock",
+		Name:      "healthy",
+		Help:      "Whether the local clock is within the tolerated skew of the NTP sources.",
+	})
+)
+
+type clockMonitor struct {
+	monitor  *clockskew.Monitor
+	interval time.Duration
+}
+
+// WithClockMonitor periodically checks the local clock against NTP sources,
+// reporting the skew through the metrics and health service. The timestamp
+// checks use the corrected time.
+func WithClockMonitor(monitor *clockskew.Monitor, interval time.Duration) Option {
+	return func(p *proverServer) {
+		p.clock = &clockMonitor{monitor: monitor, interval: interval}
+	}
+}
+
+// The current time, corrected by the offset measured against the NTP sources.
+func (p *proverServer) now() time.Time {
+	if p.clock == nil {
+		return time.Now()
+	}
+	return p.clock.monitor.Now()
+}
+
+func (p *proverServer) checkClock(wasHealthy bool) bool {
+	status, err := p.clock.monitor.Check(context.Background())
+	clockOffsetGauge.Set(status.Offset.Seconds())
+	clockReachableGauge.Set(float64(status.Reachable))
+	healthStatus := healthpb.HealthCheckResponse_NOT_SERVING
+	if status.Healthy {
+		clockHealthyGauge.Set(1)
+		healthStatus = healthpb.HealthCheckResponse_SERVING
+	} else {
+		clockHealthyGauge.Set(0)
+	}
+	if p.health != nil {
+		p.health.SetServingStatus(ClockHealthService, healthStatus)
+	}
+
+	event := log.Debug()
+	if status.Healthy != wasHealthy {
+		event = log.Warn()
+		if status.Healthy {
+			event = log.Info()
+		}
+	}
+	event.
+		Dur("offset", status.Offset).
+		Int("reachable", status.Reachable).
+		Int("sources", status.Sources).
+		Bool("healthy", status.Healthy).
+		AnErr("error", err).
+		Msg("Clock checked")
+	return status.Healthy
+}
+
+func (p *proverServer) watchClock() {
+	// Assumed healthy until proven otherwise, only the changes are logged
+	healthy := p.checkClock(true)
+	for range time.Tick(p.clock.interval) {
+		healthy = p.checkClock(healthy)
+	}
+}
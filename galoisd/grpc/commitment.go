@@ -0,0 +1,47 @@
+package grpc
+
+import (
+	"crypto/sha256"
+	"fmt"
+	grpc "galois/grpc/api/v3"
+	"galois/pkg/apierror"
+	"hash"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/consensys/gnark/backend/witness"
+	"golang.org/x/crypto/sha3"
+)
+
+func commitmentHash(scheme grpc.InputsCommitmentScheme) (hash.Hash, error) {
+	switch scheme {
+	case grpc.InputsCommitmentScheme_INPUTS_COMMITMENT_SCHEME_KECCAK256:
+		return sha3.NewLegacyKeccak256(), nil
+	case grpc.InputsCommitmentScheme_INPUTS_COMMITMENT_SCHEME_SHA256:
+		return sha256.New(), nil
+	default:
+		return nil, apierror.New(apierror.ErrInvalidRequest, "Unsupported inputs commitment scheme %s", scheme)
+	}
+}
+
+// Commit to the public inputs of a proof, in circuit order and each encoded as
+// a 32 bytes big endian integer. This is abi.encodePacked of the uint256[] the
+// EVM verifier is called with, such that a contract computes the same digest
+// with keccak256(abi.encodePacked(input)).
+func commitPublicInputs(scheme grpc.InputsCommitmentScheme, publicWitness witness.Witness) (*grpc.InputsCommitment, error) {
+	h, err := commitmentHash(scheme)
+	if err != nil {
+		return nil, err
+	}
+	inputs, ok := publicWitness.Vector().(fr.Vector)
+	if !ok {
+		return nil, fmt.Errorf("Impossible: public witness must be BN254 at this point")
+	}
+	for _, input := range inputs {
+		bz := input.Bytes()
+		h.Write(bz[:])
+	}
+	return &grpc.InputsCommitment{
+		Scheme: scheme,
+		Digest: h.Sum(nil),
+	}, nil
+}
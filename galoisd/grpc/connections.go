@@ -0,0 +1,97 @@
+package grpc
+
+import (
+	context "context"
+	grpc "galois/grpc/api/v3"
+	"galois/pkg/listener"
+	"sync/atomic"
+
+	"google.golang.org/grpc/stats"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func withConnections(tracker *listener.Tracker) Option {
+	return func(p *proverServer) {
+		p.connections = tracker
+	}
+}
+
+type trackedConnKey struct{}
+type trackedRPCKey struct{}
+
+// Receiving state of an RPC, started when its headers arrive and ended with
+// its first message, or when the RPC ends without any.
+type trackedRPC struct {
+	conn  *listener.TrackedConn
+	state atomic.Int32
+}
+
+const (
+	rpcStarted int32 = iota + 1
+	rpcReceived
+)
+
+// Reports the requests of the connections to their tracker, such that the
+// time spent receiving them is accounted.
+type connStatsHandler struct {
+	tracker *listener.Tracker
+}
+
+func (h *connStatsHandler) TagConn(ctx context.Context, info *stats.ConnTagInfo) context.Context {
+	if info.RemoteAddr == nil {
+		return ctx
+	}
+	conn := h.tracker.Lookup(info.RemoteAddr.String())
+	if conn == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, trackedConnKey{}, conn)
+}
+
+func (h *connStatsHandler) HandleConn(context.Context, stats.ConnStats) {}
+
+func (h *connStatsHandler) TagRPC(ctx context.Context, info *stats.RPCTagInfo) context.Context {
+	conn, ok := ctx.Value(trackedConnKey{}).(*listener.TrackedConn)
+	if !ok {
+		return ctx
+	}
+	return context.WithValue(ctx, trackedRPCKey{}, &trackedRPC{conn: conn})
+}
+
+func (h *connStatsHandler) HandleRPC(ctx context.Context, s stats.RPCStats) {
+	rpc, ok := ctx.Value(trackedRPCKey{}).(*trackedRPC)
+	if !ok {
+		return
+	}
+	switch s.(type) {
+	case *stats.InHeader:
+		if rpc.state.CompareAndSwap(0, rpcStarted) {
+			rpc.conn.BeginRequest()
+		}
+	case *stats.InPayload, *stats.End:
+		if rpc.state.CompareAndSwap(rpcStarted, rpcReceived) {
+			rpc.conn.EndRequest()
+		}
+	}
+}
+
+// List the open connections along with their statistics.
+func (p *proverServer) ListConnections(ctx context.Context, req *grpc.ListConnectionsRequest) (*grpc.ListConnectionsResponse, error) {
+	res := &grpc.ListConnectionsResponse{}
+	if p.connections == nil {
+		return res, nil
+	}
+	for _, conn := range p.connections.Stats() {
+		res.Connections = append(res.Connections, &grpc.ConnectionStats{
+			Remote:       conn.Remote,
+			Since:        timestamppb.New(conn.Since),
+			BytesRead:    conn.BytesRead,
+			BytesWritten: conn.BytesWritten,
+			Requests:     conn.Requests,
+			Stalled:      durationpb.New(conn.Stalled),
+			Slow:         conn.Slow,
+		})
+	}
+	return res, nil
+}
@@ -0,0 +1,57 @@
+package grpc
+
+import (
+	context "context"
+	grpc "galois/grpc/api/v3"
+	"math/rand/v2"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+const crossCheckTimeout = time.Minute
+
+type crossCheck struct {
+	client grpc.UnionProverAPIClient
+	rate   float64
+}
+
+// WithCrossCheck sends a sample of the generated proofs to another prover,
+// typically a verify-only node, for independent verification. A proof it
+// rejects means the keys of the two nodes drifted.
+func WithCrossCheck(client grpc.UnionProverAPIClient, rate float64) Option {
+	return func(p *proverServer) {
+		p.crossCheck = &crossCheck{
+			client: client,
+			rate:   rate,
+		}
+	}
+}
+
+func (p *proverServer) crossCheckProof(proveKey [32]byte, req *grpc.ProveRequest, res *grpc.ProveResponse) {
+	if p.crossCheck == nil || rand.Float64() >= p.crossCheck.rate {
+		return
+	}
+	inputsHash := InputsHash(req.Vote.ChainID, req.UntrustedHeader, res.TrustedValidatorSetRoot)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), crossCheckTimeout)
+		defer cancel()
+		verifyRes, err := p.crossCheck.client.Verify(ctx, &grpc.VerifyRequest{
+			Proof:      res.Proof,
+			InputsHash: inputsHash,
+			CircuitId:  req.CircuitId,
+		})
+		if err != nil {
+			crossChecksCounter.WithLabelValues("error").Inc()
+			log.Warn().Hex("request_hash", proveKey[:]).Err(err).Msg("Could not cross check proof")
+			return
+		}
+		if !verifyRes.Valid {
+			crossChecksCounter.WithLabelValues("mismatch").Inc()
+			log.Error().Hex("request_hash", proveKey[:]).Hex("inputs_hash", inputsHash).Msg("Cross check mismatch, the proof was rejected by the remote verifier")
+			return
+		}
+		crossChecksCounter.WithLabelValues("valid").Inc()
+		log.Debug().Hex("request_hash", proveKey[:]).Msg("cross checked")
+	}()
+}
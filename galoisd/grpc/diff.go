@@ -0,0 +1,61 @@
+package grpc
+
+import (
+	"bytes"
+	context "context"
+	"fmt"
+	grpc "galois/grpc/api/v3"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Recompute the public input commitment of a request and compare it, along with
+// the encoding of each of its fields, to the ones computed by the client. The
+// client only sends the fields it wants to compare, each encoded as hashed by
+// InputsHash.
+func (p *proverServer) DiffInputs(ctx context.Context, req *grpc.DiffInputsRequest) (*grpc.DiffInputsResponse, error) {
+	log.Debug().Msg("Diffing inputs...")
+
+	proveReq := req.Request
+	if proveReq == nil || proveReq.Vote == nil || proveReq.UntrustedHeader == nil || proveReq.TrustedCommit == nil {
+		return nil, fmt.Errorf("Missing request")
+	}
+	_, trustedValidatorsRoot, err := MarshalValidators(proveReq.TrustedCommit.Validators)
+	if err != nil {
+		return nil, fmt.Errorf("Could not marshal trusted validators %s", err)
+	}
+	fields := inputFields(proveReq.Vote.ChainID, proveReq.UntrustedHeader, trustedValidatorsRoot)
+	inputsHash := hashInputFields(fields)
+
+	res := &grpc.DiffInputsResponse{
+		InputsHash: inputsHash,
+	}
+	serverFields := make(map[string][]byte, len(fields))
+	for _, field := range fields {
+		serverFields[field.name] = field.value
+		res.Fields = append(res.Fields, &grpc.InputField{
+			Name:  field.name,
+			Value: field.value,
+		})
+	}
+	for _, clientField := range req.Fields {
+		serverValue, found := serverFields[clientField.Name]
+		if !found || !bytes.Equal(clientField.Value, serverValue) {
+			res.Mismatches = append(res.Mismatches, &grpc.InputFieldMismatch{
+				Name:   clientField.Name,
+				Client: clientField.Value,
+				Server: serverValue,
+			})
+		}
+	}
+	if len(req.InputsHash) > 0 && !bytes.Equal(req.InputsHash, inputsHash) {
+		res.Mismatches = append(res.Mismatches, &grpc.InputFieldMismatch{
+			Name:   "inputs_hash",
+			Client: req.InputsHash,
+			Server: inputsHash,
+		})
+	}
+	res.Match = len(res.Mismatches) == 0
+
+	return res, nil
+}
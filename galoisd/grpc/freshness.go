@@ -0,0 +1,66 @@
+package grpc
+
+import (
+	grpc "galois/grpc/api/v3"
+	"galois/pkg/apierror"
+	"sync"
+	"time"
+)
+
+// Rejects the requests of obsolete updates before any work is done on them.
+type freshness struct {
+	// Disabled if 0
+	window time.Duration
+	// Whether the heights requested by a client for a chain must not decrease
+	monotonic bool
+
+	mu sync.Mutex
+	// Highest height requested per client and chain
+	heights map[freshnessKey]int64
+}
+
+type freshnessKey struct {
+	client  string
+	chainID string
+}
+
+// WithFreshness rejects the requests whose header is timestamped more than
+// window before or after the local clock, corrected by the NTP offset if the
+// clock is monitored. If monotonic, it also rejects the requests of a client
+// for a height below one it already requested for the same chain.
+func WithFreshness(window time.Duration, monotonic bool) Option {
+	return func(p *proverServer) {
+		p.freshness = &freshness{
+			window:    window,
+			monotonic: monotonic,
+			heights:   make(map[freshnessKey]int64),
+		}
+	}
+}
+
+// Check a new request, recording its height if accepted.
+func (p *proverServer) checkFreshness(client string, req *grpc.ProveRequest) error {
+	f := p.freshness
+	if f == nil {
+		return nil
+	}
+	header := req.UntrustedHeader
+	if header == nil {
+		return apierror.New(apierror.ErrInvalidRequest, "Missing untrusted header")
+	}
+	if f.window > 0 {
+		if age := p.now().Sub(header.Time); age > f.window || age < -f.window {
+			return apierror.New(apierror.ErrStale, "Header at height %d is timestamped %s, more than %s away from the prover clock", header.Height, header.Time.UTC().Format(time.RFC3339), f.window)
+		}
+	}
+	if f.monotonic {
+		key := freshnessKey{client, header.ChainID}
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		if highest, found := f.heights[key]; found && header.Height < highest {
+			return apierror.New(apierror.ErrStale, "Header at height %d is below the height %d already requested for %s", header.Height, highest, header.ChainID)
+		}
+		f.heights[key] = header.Height
+	}
+	return nil
+}
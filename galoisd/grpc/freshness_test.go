@@ -0,0 +1,61 @@
+package grpc
+
+import (
+	"errors"
+	grpc "galois/grpc/api/v3"
+	"galois/pkg/apierror"
+	"testing"
+	"time"
+
+	tmtypes "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func freshnessRequest(chainID string, height int64, at time.Time) *grpc.ProveRequest {
+	return &grpc.ProveRequest{
+		UntrustedHeader: &tmtypes.Header{ChainID: chainID, Height: height, Time: at},
+	}
+}
+
+func assertStale(t *testing.T, err error) {
+	t.Helper()
+	assert.True(t, errors.Is(err, apierror.ErrStale), err)
+}
+
+func TestFreshnessWindow(t *testing.T) {
+	p := &proverServer{}
+	WithFreshness(time.Minute, false)(p)
+
+	now := time.Now()
+	// Both edges of the window, whatever the height
+	assert.NoError(t, p.checkFreshness("relayer", freshnessRequest("union", 10, now.Add(-30*time.Second))))
+	assert.NoError(t, p.checkFreshness("relayer", freshnessRequest("union", 5, now.Add(30*time.Second))))
+	assertStale(t, p.checkFreshness("relayer", freshnessRequest("union", 10, now.Add(-2*time.Minute))))
+	assertStale(t, p.checkFreshness("relayer", freshnessRequest("union", 10, now.Add(2*time.Minute))))
+
+	err := p.checkFreshness("relayer", &grpc.ProveRequest{})
+	assert.True(t, errors.Is(err, apierror.ErrInvalidRequest), err)
+}
+
+func TestFreshnessMonotonicHeights(t *testing.T) {
+	p := &proverServer{}
+	// Without window, only the heights are checked
+	WithFreshness(0, true)(p)
+	at := time.Unix(1700000000, 0)
+
+	assert.NoError(t, p.checkFreshness("relayer-a", freshnessRequest("union", 10, at)))
+	// Resubmitting the same height, e.g. after a failure, is accepted
+	assert.NoError(t, p.checkFreshness("relayer-a", freshnessRequest("union", 10, at)))
+	assertStale(t, p.checkFreshness("relayer-a", freshnessRequest("union", 9, at)))
+	assert.NoError(t, p.checkFreshness("relayer-a", freshnessRequest("union", 11, at)))
+	assertStale(t, p.checkFreshness("relayer-a", freshnessRequest("union", 10, at)))
+
+	// The heights are tracked per client and chain
+	assert.NoError(t, p.checkFreshness("relayer-b", freshnessRequest("union", 9, at)))
+	assert.NoError(t, p.checkFreshness("relayer-a", freshnessRequest("osmosis", 9, at)))
+}
+
+func TestFreshnessDisabled(t *testing.T) {
+	p := &proverServer{}
+	assert.NoError(t, p.checkFreshness("relayer", &grpc.ProveRequest{}))
+}
@@ -0,0 +1,52 @@
+package grpc
+
+import (
+	context "context"
+	grpc "galois/grpc/api/v3"
+	"galois/pkg/lightclient"
+	"runtime"
+
+	"github.com/rs/zerolog/log"
+	"golang.org/x/sys/cpu"
+)
+
+// CPU features the field arithmetic and MSMs benefit from.
+func cpuFeatures() []string {
+	var features []string
+	add := func(name string, present bool) {
+		if present {
+			features = append(features, name)
+		}
+	}
+	switch runtime.GOARCH {
+	case "amd64":
+		add("adx", cpu.X86.HasADX)
+		add("bmi2", cpu.X86.HasBMI2)
+		add("avx2", cpu.X86.HasAVX2)
+		add("avx512f", cpu.X86.HasAVX512F)
+	case "arm64":
+		add("asimd", cpu.ARM64.HasASIMD)
+		add("pmull", cpu.ARM64.HasPMULL)
+		add("sha2", cpu.ARM64.HasSHA2)
+		add("atomics", cpu.ARM64.HasATOMICS)
+	}
+	return features
+}
+
+func (p *proverServer) GetInfo(ctx context.Context, req *grpc.GetInfoRequest) (*grpc.GetInfoResponse, error) {
+	log.Debug().Msg("Getting info...")
+
+	return &grpc.GetInfoResponse{
+		GoVersion:                  runtime.Version(),
+		Os:                         runtime.GOOS,
+		Arch:                       runtime.GOARCH,
+		NumCpu:                     uint32(runtime.NumCPU()),
+		MaxProcs:                   uint32(runtime.GOMAXPROCS(0)),
+		AcceleratedFieldArithmetic: acceleratedFieldArithmetic,
+		CpuFeatures:                cpuFeatures(),
+		CircuitId:                  p.circuitID,
+		Circuits:                   p.circuitLoads(),
+		CircuitProfile:             lightclient.Profile,
+		MaxValidators:              lightclient.MaxVal,
+	}, nil
+}
@@ -0,0 +1,7 @@
+//go:build amd64 && !purego
+
+package grpc
+
+// gnark-crypto implements the BN254 field arithmetic in assembly on amd64, using
+// ADX and BMI2 when available.
+const acceleratedFieldArithmetic = true
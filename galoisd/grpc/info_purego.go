@@ -0,0 +1,7 @@
+//go:build !amd64 || purego
+
+package grpc
+
+// On other architectures, arm64 included, gnark-crypto falls back to its
+// generic Go implementation of the field arithmetic.
+const acceleratedFieldArithmetic = false
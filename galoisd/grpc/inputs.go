@@ -0,0 +1,101 @@
+package grpc
+
+import (
+	context "context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	grpc "galois/grpc/api/v3"
+	"galois/pkg/apierror"
+	"galois/pkg/storage"
+	"path"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/proto"
+)
+
+// Default directory of the storage backend where uploaded inputs are kept.
+const defaultInputsDir = "inputs"
+
+// WithInputsDir sets the directory of the storage backend where the inputs
+// uploaded through UploadInputs are kept.
+func WithInputsDir(dir string) Option {
+	return func(p *proverServer) {
+		p.inputsDir = dir
+	}
+}
+
+// The inputs of the authenticated clients are kept in a directory of their
+// tenant, such that a handle is only valid for the tenant that uploaded it.
+func (p *proverServer) inputsPath(tenant string, handle []byte) string {
+	if tenant == "" {
+		return path.Join(p.inputsDir, hex.EncodeToString(handle)+".pb")
+	}
+	tenantHash := sha256.Sum256([]byte(tenant))
+	return path.Join(p.inputsDir, hex.EncodeToString(tenantHash[:]), hex.EncodeToString(handle)+".pb")
+}
+
+// Store the inputs of a proof under their hash, returned as handle.
+func (p *proverServer) storeInputs(ctx context.Context, req *grpc.ProveRequest) ([]byte, error) {
+	bz, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("Could not encode inputs %s", err)
+	}
+	handle := sha256.Sum256(bz)
+	key := p.inputsPath(tenantFromContext(ctx), handle[:])
+	if err := storage.WriteAll(ctx, p.storage, key, bz); err != nil {
+		return nil, fmt.Errorf("Could not store inputs %s", err)
+	}
+
+	log.Info().Hex("handle", handle[:]).Int("bytes", len(bz)).Msg("upload")
+
+	return handle[:], nil
+}
+
+// Store the inputs of a proof, such that they can be proven several times
+// without being sent again. The handle is the hash of the inputs, uploading
+// them twice is a no-op.
+func (p *proverServer) UploadInputs(ctx context.Context, req *grpc.UploadInputsRequest) (*grpc.UploadInputsResponse, error) {
+	log.Debug().Msg("Uploading inputs...")
+
+	if req.Request == nil {
+		return nil, fmt.Errorf("Missing request")
+	}
+	handle, err := p.storeInputs(ctx, req.Request)
+	if err != nil {
+		return nil, err
+	}
+
+	return &grpc.UploadInputsResponse{
+		Handle: handle,
+	}, nil
+}
+
+// Same as Poll, with the inputs previously uploaded. The circuit ID and inputs
+// commitment scheme, if any, override the ones of the uploaded request.
+func (p *proverServer) ProveFromHandle(ctx context.Context, req *grpc.ProveFromHandleRequest) (*grpc.PollResponse, error) {
+	if len(req.Handle) != sha256.Size {
+		return nil, apierror.New(apierror.ErrInvalidRequest, "Invalid handle, expected %d bytes", sha256.Size)
+	}
+	bz, err := storage.ReadAll(ctx, p.storage, p.inputsPath(tenantFromContext(ctx), req.Handle))
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil, apierror.New(apierror.ErrUnknownHandle, "Unknown handle %x", req.Handle)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Could not load inputs %s", err)
+	}
+	var proveReq grpc.ProveRequest
+	if err := proto.Unmarshal(bz, &proveReq); err != nil {
+		return nil, fmt.Errorf("Could not decode inputs %s", err)
+	}
+	if len(req.CircuitId) > 0 {
+		proveReq.CircuitId = req.CircuitId
+	}
+	if req.InputsCommitmentScheme != grpc.InputsCommitmentScheme_INPUTS_COMMITMENT_SCHEME_UNSPECIFIED {
+		proveReq.InputsCommitmentScheme = req.InputsCommitmentScheme
+	}
+	return p.Poll(ctx, &grpc.PollRequest{
+		Request: &proveReq,
+	})
+}
@@ -0,0 +1,185 @@
+package grpc
+
+import (
+	context "context"
+	grpc "galois/grpc/api/v3"
+	"galois/pkg/apierror"
+	"galois/pkg/authz"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Owner of the jobs resumed from a snapshot.
+const snapshotOwner = "snapshot"
+
+// Bookkeeping of a proof job, the state itself lives in the results map.
+type job struct {
+	mu          sync.Mutex
+	owner       string
+	submittedAt time.Time
+	startedAt   time.Time
+	finishedAt  time.Time
+	inputsHash  []byte
+	// Empty for the current circuit
+	circuitID []byte
+	// Height of the proven header, used to evict superseded proofs
+	chainID string
+	height  int64
+	// Kept for the snapshots to carry the requests of the finished jobs
+	request *grpc.ProveRequest
+	// Whether the owner is an authenticated principal rather than an address
+	authenticated bool
+	// Namespace of the job, empty for the unauthenticated clients
+	tenant string
+	// Whether the job gave back its slot of the tenant quota
+	quotaReleased bool
+}
+
+// Principal or, if unauthenticated, address of the client that issued the
+// request, if any.
+func ownerFromContext(ctx context.Context) string {
+	owner, _ := callerFromContext(ctx)
+	return owner
+}
+
+// Identity of the caller and whether it is authenticated: the one attached by
+// an authentication interceptor, the principal authorized by the policy, or
+// the address of the client.
+func callerFromContext(ctx context.Context) (string, bool) {
+	if id, ok := ctx.Value(clientIDKey{}).(string); ok && id != "" {
+		return id, true
+	}
+	if principal, ok := authz.PrincipalFromContext(ctx); ok {
+		return principal, true
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		return p.Addr.String(), false
+	}
+	return "", false
+}
+
+func (p *proverServer) trackJob(proveKey [32]byte, owner string, authenticated bool, tenant string, req *grpc.ProveRequest) {
+	p.jobs.Store(proveKey, &job{
+		owner:         owner,
+		authenticated: authenticated,
+		tenant:        tenant,
+		submittedAt:   time.Now(),
+		circuitID:     req.GetCircuitId(),
+		chainID:       req.GetVote().GetChainID(),
+		height:        req.GetUntrustedHeader().GetHeight(),
+		request:       req,
+	})
+}
+
+// Snapshots don't carry the bookkeeping of the finished jobs, their retention
+// starts from the restoration.
+func (p *proverServer) trackRestoredJob(proveKey [32]byte, tenant string, req *grpc.ProveRequest, inputsHash []byte) {
+	owner, authenticated := snapshotOwner, false
+	if tenant != "" {
+		owner, authenticated = tenant, true
+	}
+	p.jobs.Store(proveKey, &job{
+		owner:         owner,
+		authenticated: authenticated,
+		tenant:        tenant,
+		finishedAt:    time.Now(),
+		inputsHash:    inputsHash,
+		circuitID:     req.GetCircuitId(),
+		chainID:       req.GetVote().GetChainID(),
+		height:        req.GetUntrustedHeader().GetHeight(),
+		request:       req,
+	})
+}
+
+func (p *proverServer) updateJob(proveKey [32]byte, f func(j *job)) {
+	value, found := p.jobs.Load(proveKey)
+	if !found {
+		return
+	}
+	j := value.(*job)
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	f(j)
+}
+
+func timestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+// Build the public view of a job from its result and bookkeeping.
+func (p *proverServer) describeJob(proveKey [32]byte, result any) *grpc.Job {
+	desc := &grpc.Job{
+		RequestHash: proveKey[:],
+	}
+	value, found := p.jobs.Load(proveKey)
+	if found {
+		j := value.(*job)
+		j.mu.Lock()
+		desc.Owner = j.owner
+		desc.InputsHash = j.inputsHash
+		desc.SubmittedAt = timestamp(j.submittedAt)
+		desc.StartedAt = timestamp(j.startedAt)
+		desc.FinishedAt = timestamp(j.finishedAt)
+		j.mu.Unlock()
+	}
+	switch _result := result.(type) {
+	case *grpc.ProveRequestPending:
+		if desc.StartedAt != nil {
+			desc.State = grpc.JobState_JOB_STATE_RUNNING
+		} else {
+			desc.State = grpc.JobState_JOB_STATE_QUEUED
+		}
+	case *grpc.ProveResponse:
+		desc.State = grpc.JobState_JOB_STATE_DONE
+	case error:
+		if _result == errJobCancelled {
+			desc.State = grpc.JobState_JOB_STATE_CANCELLED
+		} else {
+			desc.State = grpc.JobState_JOB_STATE_FAILED
+		}
+		desc.Error = _result.Error()
+	}
+	return desc
+}
+
+func (p *proverServer) ListJobs(ctx context.Context, req *grpc.ListJobsRequest) (*grpc.ListJobsResponse, error) {
+	log.Debug().Msg("Listing jobs...")
+
+	states := make(map[grpc.JobState]bool, len(req.States))
+	for _, state := range req.States {
+		states[state] = true
+	}
+	res := &grpc.ListJobsResponse{}
+	p.results.Range(func(key, value any) bool {
+		desc := p.describeJob(key.([32]byte), value)
+		if len(states) == 0 || states[desc.State] {
+			res.Jobs = append(res.Jobs, desc)
+		}
+		return true
+	})
+	return res, nil
+}
+
+func (p *proverServer) GetJob(ctx context.Context, req *grpc.GetJobRequest) (*grpc.GetJobResponse, error) {
+	log.Debug().Hex("request_hash", req.RequestHash).Msg("Getting job...")
+
+	var proveKey [32]byte
+	if len(req.RequestHash) != len(proveKey) {
+		return nil, apierror.New(apierror.ErrInvalidRequest, "Invalid request hash, expected %d bytes", len(proveKey))
+	}
+	copy(proveKey[:], req.RequestHash)
+	result, found := p.results.Load(proveKey)
+	if !found {
+		return nil, apierror.New(apierror.ErrUnknownJob, "Unknown job %x", proveKey)
+	}
+	return &grpc.GetJobResponse{
+		Job: p.describeJob(proveKey, result),
+	}, nil
+}
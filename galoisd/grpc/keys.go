@@ -0,0 +1,146 @@
+package grpc
+
+import (
+	"bytes"
+	context "context"
+	"crypto/sha256"
+	"fmt"
+	grpc "galois/grpc/api/v3"
+	"galois/pkg/apierror"
+	"galois/pkg/verify"
+	"runtime/debug"
+	"time"
+
+	backend_bn254 "github.com/consensys/gnark/backend/groth16/bn254"
+	cs_bn254 "github.com/consensys/gnark/constraint/bn254"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// A circuit along with its keys. The circuit ID is the sha256 of the verifying
+// key, as returned in the attestation.
+type keyset struct {
+	cs cs_bn254.R1CS
+	pk backend_bn254.ProvingKey
+	vk backend_bn254.VerifyingKey
+	// The verifying key decoded by the standalone verifier
+	verifier  *verify.VerifyingKey
+	circuitID []byte
+	// How the circuit and keys were loaded
+	loaded loadReport
+	proofs *proofStats
+	// When the keys are unloaded, zero for the current ones
+	expiresAt time.Time
+}
+
+func newKeyset(cs cs_bn254.R1CS, pk backend_bn254.ProvingKey, vk backend_bn254.VerifyingKey) (*keyset, error) {
+	var vkBuffer bytes.Buffer
+	if _, err := vk.WriteTo(&vkBuffer); err != nil {
+		return nil, err
+	}
+	circuitID := sha256.Sum256(vkBuffer.Bytes())
+	verifier, err := verify.ReadVerifyingKey(&vkBuffer)
+	if err != nil {
+		return nil, err
+	}
+	return &keyset{
+		cs:        cs,
+		pk:        pk,
+		vk:        vk,
+		verifier:  verifier,
+		circuitID: circuitID[:],
+		proofs:    &proofStats{},
+	}, nil
+}
+
+// Keys of the previous circuit, still served until the end of the rollover window.
+type rollover struct {
+	r1csPath string
+	pkPath   string
+	vkPath   string
+	window   time.Duration
+}
+
+// Select the keys of a request, an empty circuit ID picking the current ones.
+func (p *proverServer) keysFor(circuitID []byte) (*keyset, error) {
+	if len(circuitID) == 0 || bytes.Equal(circuitID, p.circuitID) {
+		return p.current.Load(), nil
+	}
+	if previous := p.previous.Load(); previous != nil && bytes.Equal(circuitID, previous.circuitID) {
+		return previous, nil
+	}
+	return nil, apierror.New(apierror.ErrCircuitMismatch, "Unknown circuit %x, the prover serves %x", circuitID, p.circuitID)
+}
+
+func (p *proverServer) loadPreviousKeys() error {
+	var report loadReport
+	cs, pk, vk, err := loadKeys(p.storage, p.rollover.r1csPath, p.rollover.pkPath, p.rollover.vkPath, &report)
+	if err != nil {
+		return fmt.Errorf("Could not load the previous keys %s", err)
+	}
+	previous, err := newKeyset(cs, pk, vk)
+	if err != nil {
+		return err
+	}
+	previous.loaded = report
+	previous.expiresAt = time.Now().Add(p.rollover.window)
+	if bytes.Equal(previous.circuitID, p.circuitID) {
+		return fmt.Errorf("The previous keys are the same as the current ones")
+	}
+	p.previous.Store(previous)
+	log.Info().
+		Hex("circuit_id", previous.circuitID).
+		Time("expires_at", previous.expiresAt).
+		Msg("Serving previous circuit")
+	time.AfterFunc(p.rollover.window, p.unloadPreviousKeys)
+	return nil
+}
+
+// Load the current keys again, e.g. after their corruption was detected. The
+// reloaded keys must be for the same circuit, changing circuits goes through a
+// rollover. Running jobs complete on the keys they started with.
+func (p *proverServer) ReloadKeys(ctx context.Context, req *grpc.ReloadKeysRequest) (*grpc.ReloadKeysResponse, error) {
+	log.Debug().Msg("Reloading keys...")
+
+	if !p.reloadMu.TryLock() {
+		return nil, apierror.New(apierror.ErrKeysReloading, "The keys are already being reloaded")
+	}
+	defer p.reloadMu.Unlock()
+	start := time.Now()
+	var report loadReport
+	cs, pk, vk, err := loadKeys(p.storage, p.r1csPath, p.pkPath, p.vkPath, &report)
+	if err != nil {
+		return nil, apierror.New(apierror.ErrCircuitMismatch, "Could not reload the keys %s", err)
+	}
+	keys, err := newKeyset(cs, pk, vk)
+	if err != nil {
+		return nil, apierror.New(apierror.ErrCircuitMismatch, "Could not reload the keys %s", err)
+	}
+	if !bytes.Equal(keys.circuitID, p.circuitID) {
+		return nil, apierror.New(apierror.ErrCircuitMismatch, "The reloaded keys are for circuit %x, the prover serves %x", keys.circuitID, p.circuitID)
+	}
+	keys.loaded = report
+	keys.proofs = p.current.Load().proofs
+	p.current.Store(keys)
+	elapsed := time.Since(start)
+	log.Info().Hex("circuit_id", keys.circuitID).Dur("elapsed", elapsed).Msg("Keys reloaded")
+	debug.FreeOSMemory()
+
+	return &grpc.ReloadKeysResponse{
+		CircuitId: keys.circuitID,
+		Elapsed:   durationpb.New(elapsed),
+	}, nil
+}
+
+// Jobs already running on the previous keys keep a reference to them and
+// complete, new requests for the previous circuit are rejected.
+func (p *proverServer) unloadPreviousKeys() {
+	previous := p.previous.Swap(nil)
+	if previous == nil {
+		return
+	}
+	log.Info().Hex("circuit_id", previous.circuitID).Msg("Rollover window expired, unloading previous circuit")
+	p.releaseReservation(previous.circuitID)
+	// The proving key weighs gigabytes, give it back to the OS right away.
+	debug.FreeOSMemory()
+}
@@ -0,0 +1,33 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	grpc "galois/grpc/api/v3"
+	"galois/pkg/apierror"
+	"galois/pkg/storage"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReloadKeysErrors(t *testing.T) {
+	dir := t.TempDir()
+	p := &proverServer{
+		storage:   storage.NewFilesystem(""),
+		r1csPath:  filepath.Join(dir, "r1cs.bin"),
+		pkPath:    filepath.Join(dir, "pk.bin"),
+		vkPath:    filepath.Join(dir, "vk.bin"),
+		circuitID: currentCircuit,
+	}
+
+	// The keys can't be loaded
+	_, err := p.ReloadKeys(context.Background(), &grpc.ReloadKeysRequest{})
+	assert.True(t, errors.Is(err, apierror.ErrCircuitMismatch), err)
+
+	p.reloadMu.Lock()
+	_, err = p.ReloadKeys(context.Background(), &grpc.ReloadKeysRequest{})
+	p.reloadMu.Unlock()
+	assert.True(t, errors.Is(err, apierror.ErrKeysReloading), err)
+}
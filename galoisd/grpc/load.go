@@ -0,0 +1,183 @@
+package grpc
+
+import (
+	"bytes"
+	context "context"
+	grpc "galois/grpc/api/v3"
+	"galois/pkg/apierror"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// Suggested retry delay when no proof has been computed yet.
+const defaultRetryAfter = 5 * time.Second
+
+// Exponential moving average of the proving time.
+type proveTime struct {
+	mu      sync.Mutex
+	average time.Duration
+}
+
+func (t *proveTime) record(elapsed time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.average == 0 {
+		t.average = elapsed
+	} else {
+		t.average = (7*t.average + elapsed) / 8
+	}
+}
+
+func (t *proveTime) get() time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.average
+}
+
+// Number of proofs the recent proving time of a circuit is averaged over.
+const recentProofs = 16
+
+// Proving times of the successful proofs of a circuit.
+type proofStats struct {
+	mu     sync.Mutex
+	recent [recentProofs]time.Duration
+	count  uint64
+}
+
+func (s *proofStats) record(elapsed time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.recent[s.count%recentProofs] = elapsed
+	s.count++
+}
+
+// Average over the last proofs, the number of proofs it is computed over and
+// the total number of proofs.
+func (s *proofStats) get() (time.Duration, uint32, uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	n := min(s.count, recentProofs)
+	if n == 0 {
+		return 0, 0, 0
+	}
+	var sum time.Duration
+	for _, elapsed := range s.recent[:n] {
+		sum += elapsed
+	}
+	return sum / time.Duration(n), uint32(n), s.count
+}
+
+// Load of each circuit served, the current one first. The keys are cold until
+// they have been used for a proof, the first one being slower.
+func (p *proverServer) circuitLoads() []*grpc.CircuitLoad {
+	keysets := []*keyset{p.current.Load()}
+	if previous := p.previous.Load(); previous != nil {
+		keysets = append(keysets, previous)
+	}
+	loads := make([]*grpc.CircuitLoad, len(keysets))
+	for i, keys := range keysets {
+		recent, nbRecent, proofs := keys.proofs.get()
+		state := grpc.KeysState_KEYS_STATE_COLD
+		if proofs > 0 {
+			state = grpc.KeysState_KEYS_STATE_WARM
+		}
+		reservation := p.reservationOf(keys.circuitID)
+		loads[i] = &grpc.CircuitLoad{
+			CircuitId:       keys.circuitID,
+			RecentProveTime: durationpb.New(recent),
+			RecentProofs:    nbRecent,
+			Proofs:          proofs,
+			KeysState:       state,
+			ExpiresAt:       timestamp(keys.expiresAt),
+			ReservedSlots:   reservation.Slots,
+			Pinned:          reservation.Pinned,
+		}
+	}
+	// Both the running and the queued jobs have a request, only the former
+	// have started.
+	p.requests.Range(func(key, value any) bool {
+		circuitID := value.(*grpc.ProveRequest).CircuitId
+		if len(circuitID) == 0 {
+			circuitID = p.circuitID
+		}
+		var started bool
+		p.updateJob(key.([32]byte), func(j *job) {
+			started = !j.startedAt.IsZero()
+		})
+		for _, load := range loads {
+			if bytes.Equal(circuitID, load.CircuitId) {
+				if started {
+					load.RunningJobs++
+				} else {
+					load.QueuedJobs++
+				}
+			}
+		}
+		return true
+	})
+	return loads
+}
+
+func (p *proverServer) load() *grpc.GetLoadResponse {
+	running := p.nbJobs.Load()
+	average := p.proveTime.get()
+
+	// A slot is free, or will be once the most advanced job is done
+	var estimatedWait, retryAfter time.Duration
+	if running >= p.maxJobs {
+		if average == 0 {
+			retryAfter = defaultRetryAfter
+		} else {
+			estimatedWait = average
+			p.requests.Range(func(key, _ any) bool {
+				value, found := p.jobs.Load(key)
+				if !found {
+					return true
+				}
+				j := value.(*job)
+				j.mu.Lock()
+				startedAt := j.startedAt
+				j.mu.Unlock()
+				if !startedAt.IsZero() {
+					estimatedWait = min(estimatedWait, max(average-time.Since(startedAt), 0))
+				}
+				return true
+			})
+			retryAfter = max(estimatedWait, time.Second)
+		}
+	}
+
+	return &grpc.GetLoadResponse{
+		RunningJobs:      running,
+		MaxJobs:          p.maxJobs,
+		AverageProveTime: durationpb.New(average),
+		EstimatedWait:    durationpb.New(estimatedWait),
+		RetryAfter:       durationpb.New(retryAfter),
+		State:            p.servingState(),
+		Maintenance:      p.maintenanceWindow(),
+		QueuedJobs:       p.queuedJobs(),
+		Circuits:         p.circuitLoads(),
+	}
+}
+
+// The error returned when all the proving slots are taken, hinting the client
+// when to retry.
+func (p *proverServer) busyError() error {
+	load := p.load()
+	return apierror.ErrOverloaded.WithDetails(
+		&errdetails.RetryInfo{
+			RetryDelay: load.RetryAfter,
+		},
+		load,
+	)
+}
+
+func (p *proverServer) GetLoad(ctx context.Context, req *grpc.GetLoadRequest) (*grpc.GetLoadResponse, error) {
+	log.Debug().Msg("Getting load...")
+
+	return p.load(), nil
+}
@@ -0,0 +1,24 @@
+package grpc
+
+import (
+	context "context"
+	grpc "galois/grpc/api/v3"
+	"galois/pkg/apierror"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+// Change the global log level of the daemon until it restarts.
+func (p *proverServer) SetLogLevel(ctx context.Context, req *grpc.SetLogLevelRequest) (*grpc.SetLogLevelResponse, error) {
+	level, err := zerolog.ParseLevel(req.Level)
+	if err != nil || req.Level == "" {
+		return nil, apierror.New(apierror.ErrInvalidRequest, "Unknown log level %q, expected one of trace, debug, info, warn, error, fatal, panic or disabled", req.Level)
+	}
+	previous := zerolog.GlobalLevel()
+	zerolog.SetGlobalLevel(level)
+	log.Info().Str("level", level.String()).Str("previous_level", previous.String()).Msg("Log level changed")
+	return &grpc.SetLogLevelResponse{
+		PreviousLevel: previous.String(),
+	}, nil
+}
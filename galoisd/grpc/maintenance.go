@@ -0,0 +1,149 @@
+package grpc
+
+import (
+	context "context"
+	"fmt"
+	grpc "galois/grpc/api/v3"
+	"galois/pkg/apierror"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// A scheduled maintenance. From drainAt until end, the server advertises it is
+// draining and rejects new jobs, letting the running ones complete.
+type maintenance struct {
+	mu      sync.Mutex
+	drainAt time.Time
+	start   time.Time
+	end     time.Time
+	timers  []*time.Timer
+}
+
+// WithHealthServer reports the serving status, NOT_SERVING while draining for a
+// maintenance, through the standard gRPC health service.
+func WithHealthServer(h *health.Server) Option {
+	return func(p *proverServer) {
+		p.health = h
+	}
+}
+
+func (m *maintenance) window() *grpc.MaintenanceWindow {
+	if m.end.IsZero() {
+		return nil
+	}
+	return &grpc.MaintenanceWindow{
+		DrainAt: timestamp(m.drainAt),
+		Start:   timestamp(m.start),
+		End:     timestamp(m.end),
+	}
+}
+
+func (p *proverServer) maintenanceWindow() *grpc.MaintenanceWindow {
+	p.maintenance.mu.Lock()
+	defer p.maintenance.mu.Unlock()
+	return p.maintenance.window()
+}
+
+// The end of the ongoing maintenance if the server is draining.
+func (p *proverServer) drainingUntil() (time.Time, bool) {
+	p.maintenance.mu.Lock()
+	defer p.maintenance.mu.Unlock()
+	now := time.Now()
+	m := &p.maintenance
+	if m.end.IsZero() || now.Before(m.drainAt) || !now.Before(m.end) {
+		return time.Time{}, false
+	}
+	return m.end, true
+}
+
+func (p *proverServer) servingState() grpc.ServingState {
+	if _, draining := p.drainingUntil(); draining {
+		return grpc.ServingState_SERVING_STATE_DRAINING
+	}
+	return grpc.ServingState_SERVING_STATE_SERVING
+}
+
+func (p *proverServer) updateServingStatus() {
+	state := p.servingState()
+	log.Info().Str("state", state.String()).Msg("Serving state")
+	if p.health == nil {
+		return
+	}
+	healthStatus := healthpb.HealthCheckResponse_SERVING
+	if state == grpc.ServingState_SERVING_STATE_DRAINING {
+		healthStatus = healthpb.HealthCheckResponse_NOT_SERVING
+	}
+	p.health.SetServingStatus("", healthStatus)
+	p.health.SetServingStatus(grpc.UnionProverAPI_ServiceDesc.ServiceName, healthStatus)
+}
+
+// The error returned to new jobs submitted while draining.
+func drainingError(end time.Time) error {
+	return apierror.ErrDraining.WithDetails(&errdetails.RetryInfo{
+		RetryDelay: durationpb.New(time.Until(end)),
+	})
+}
+
+func (p *proverServer) setMaintenance(drainAt, start, end time.Time) {
+	m := &p.maintenance
+	m.mu.Lock()
+	for _, timer := range m.timers {
+		timer.Stop()
+	}
+	m.drainAt, m.start, m.end = drainAt, start, end
+	m.timers = nil
+	if !end.IsZero() {
+		m.timers = append(
+			m.timers,
+			time.AfterFunc(time.Until(drainAt), p.updateServingStatus),
+			time.AfterFunc(time.Until(end), func() {
+				p.setMaintenance(time.Time{}, time.Time{}, time.Time{})
+			}),
+		)
+	}
+	m.mu.Unlock()
+	p.updateServingStatus()
+}
+
+func (p *proverServer) ScheduleMaintenance(ctx context.Context, req *grpc.ScheduleMaintenanceRequest) (*grpc.ScheduleMaintenanceResponse, error) {
+	log.Debug().Msg("Scheduling maintenance...")
+
+	if req.Window == nil || req.Window.Start == nil || req.Window.End == nil {
+		return nil, fmt.Errorf("Missing maintenance window")
+	}
+	start := req.Window.Start.AsTime()
+	end := req.Window.End.AsTime()
+	drainAt := start
+	if req.Window.DrainAt != nil {
+		drainAt = req.Window.DrainAt.AsTime()
+	}
+	if !drainAt.Before(end) || end.Before(start) || start.Before(drainAt) {
+		return nil, fmt.Errorf("The maintenance must drain before starting, and start before ending")
+	}
+	if !end.After(time.Now()) {
+		return nil, fmt.Errorf("The maintenance window is already over")
+	}
+	p.setMaintenance(drainAt, start, end)
+
+	log.Info().Time("drain_at", drainAt).Time("start", start).Time("end", end).Msg("maintenance scheduled")
+
+	return &grpc.ScheduleMaintenanceResponse{
+		Window: p.maintenanceWindow(),
+	}, nil
+}
+
+func (p *proverServer) CancelMaintenance(ctx context.Context, req *grpc.CancelMaintenanceRequest) (*grpc.CancelMaintenanceResponse, error) {
+	log.Debug().Msg("Cancelling maintenance...")
+
+	p.setMaintenance(time.Time{}, time.Time{}, time.Time{})
+
+	log.Info().Msg("maintenance cancelled")
+
+	return &grpc.CancelMaintenanceResponse{}, nil
+}
@@ -0,0 +1,54 @@
+package grpc
+
+import (
+	grpc "galois/grpc/api/v3"
+	"galois/pkg/lightclient"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// Distributions of the inputs actually submitted, to size the next circuits.
+var (
+	validatorsHistogram = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "galoisd",
+		Subsystem: "prover",
+		Name:      "validators",
+		Help:      "Number of validators of the commits submitted for proving.",
+		Buckets:   prometheus.LinearBuckets(8, 8, max(lightclient.MaxVal/8, 1)),
+	}, []string{"commit"})
+	signaturesHistogram = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "galoisd",
+		Subsystem: "prover",
+		Name:      "signatures",
+		Help:      "Number of signatures of the commits submitted for proving.",
+		Buckets:   prometheus.LinearBuckets(8, 8, max(lightclient.MaxVal/8, 1)),
+	}, []string{"commit"})
+	proofBytesHistogram = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "galoisd",
+		Subsystem: "prover",
+		Name:      "proof_bytes",
+		Help:      "Size in bytes of the generated proofs.",
+		Buckets:   prometheus.ExponentialBuckets(128, 2, 6),
+	}, []string{"encoding"})
+)
+
+var crossChecksCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "galoisd",
+	Subsystem: "prover",
+	Name:      "cross_checks_total",
+	Help:      "Number of proofs cross checked against a remote verifier, by result.",
+}, []string{"result"})
+
+func observeRequest(req *grpc.ProveRequest) {
+	validatorsHistogram.WithLabelValues("trusted").Observe(float64(len(req.TrustedCommit.Validators)))
+	validatorsHistogram.WithLabelValues("untrusted").Observe(float64(len(req.UntrustedCommit.Validators)))
+	signaturesHistogram.WithLabelValues("trusted").Observe(float64(len(req.TrustedCommit.Signatures)))
+	signaturesHistogram.WithLabelValues("untrusted").Observe(float64(len(req.UntrustedCommit.Signatures)))
+}
+
+func observeProof(proof *grpc.ZeroKnowledgeProof) {
+	proofBytesHistogram.WithLabelValues("raw").Observe(float64(len(proof.Content)))
+	proofBytesHistogram.WithLabelValues("compressed").Observe(float64(len(proof.CompressedContent)))
+	proofBytesHistogram.WithLabelValues("evm").Observe(float64(len(proof.EvmProof)))
+}
@@ -0,0 +1,50 @@
+package grpc
+
+import (
+	"galois/pkg/storage"
+	"time"
+)
+
+// Option customizes a prover server created through NewProverServer.
+type Option func(*proverServer)
+
+// ProveStartHook is invoked right before a new proof job starts computing.
+type ProveStartHook func(requestHash []byte)
+
+// ProveDoneHook is invoked once a proof job finished, err being nil on success.
+type ProveDoneHook func(requestHash []byte, elapsed time.Duration, err error)
+
+// WithProveStartHook registers a hook called whenever a new proof job is started.
+func WithProveStartHook(hook ProveStartHook) Option {
+	return func(p *proverServer) {
+		p.onProveStart = append(p.onProveStart, hook)
+	}
+}
+
+// WithProveDoneHook registers a hook called whenever a proof job is done.
+func WithProveDoneHook(hook ProveDoneHook) Option {
+	return func(p *proverServer) {
+		p.onProveDone = append(p.onProveDone, hook)
+	}
+}
+
+// WithStorage sets the backend the circuit, keys and proofs are loaded from and
+// saved to, the working directory being used by default.
+func WithStorage(backend storage.Backend) Option {
+	return func(p *proverServer) {
+		p.storage = backend
+	}
+}
+
+// WithPreviousKeys keeps serving the requests for a previous circuit, selected
+// by their circuit ID, until the rollover window expires.
+func WithPreviousKeys(r1csPath string, pkPath string, vkPath string, window time.Duration) Option {
+	return func(p *proverServer) {
+		p.rollover = &rollover{
+			r1csPath: r1csPath,
+			pkPath:   pkPath,
+			vkPath:   vkPath,
+			window:   window,
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package grpc
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	grpc "galois/grpc/api/v3"
+	"galois/pkg/storage"
+	"path"
+
+	"github.com/rs/zerolog/log"
+)
+
+// A generated proof as written in the proof output directory.
+type PersistedProof struct {
+	RequestHash []byte              `json:"request_hash"`
+	InputsHash  []byte              `json:"inputs_hash"`
+	Request     *grpc.ProveRequest  `json:"request"`
+	Response    *grpc.ProveResponse `json:"response"`
+	// Tenant the request hash is namespaced by, if any
+	Tenant string `json:"tenant,omitempty"`
+}
+
+// WithProofOutputDir writes every generated proof along with its public inputs to
+// dir of the storage backend, the object being named after the request hash.
+func WithProofOutputDir(dir string) Option {
+	return func(p *proverServer) {
+		p.proofOutputDir = dir
+	}
+}
+
+func ProofPath(dir string, requestHash []byte) string {
+	return path.Join(dir, hex.EncodeToString(requestHash)+".json")
+}
+
+func (p *proverServer) persistProof(proveKey [32]byte, req *grpc.ProveRequest, res *grpc.ProveResponse) {
+	if p.proofOutputDir == "" {
+		return
+	}
+	key := ProofPath(p.proofOutputDir, proveKey[:])
+	var tenant string
+	p.updateJob(proveKey, func(j *job) {
+		tenant = j.tenant
+	})
+	bz, err := json.Marshal(&PersistedProof{
+		Tenant:      tenant,
+		RequestHash: proveKey[:],
+		InputsHash:  InputsHash(req.Vote.ChainID, req.UntrustedHeader, res.TrustedValidatorSetRoot),
+		Request:     req,
+		Response:    res,
+	})
+	if err != nil {
+		log.Error().Hex("request_hash", proveKey[:]).Err(err).Msg("Could not encode proof")
+		return
+	}
+	if err := storage.WriteAll(context.Background(), p.storage, key, bz); err != nil {
+		log.Error().Hex("request_hash", proveKey[:]).Str("path", key).Err(err).Msg("Could not persist proof")
+		return
+	}
+	log.Debug().Hex("request_hash", proveKey[:]).Str("path", key).Msg("persisted")
+}
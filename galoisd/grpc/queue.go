@@ -0,0 +1,116 @@
+package grpc
+
+import (
+	context "context"
+	"encoding/json"
+	"fmt"
+	grpc "galois/grpc/api/v3"
+	"galois/pkg/fairqueue"
+	"net"
+	"os"
+
+	"github.com/rs/zerolog/log"
+)
+
+type clientIDKey struct{}
+
+// A job waiting for a proving slot.
+type queuedJob struct {
+	req     *grpc.ProveRequest
+	reqJson []byte
+}
+
+// Weights of the clients sharing the queue, loaded from a JSON file.
+type QueueWeights struct {
+	// Weight of the clients not listed, 1 if unset.
+	Default float64            `json:"default"`
+	Clients map[string]float64 `json:"clients"`
+}
+
+func ReadQueueWeights(path string) (QueueWeights, error) {
+	var weights QueueWeights
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return weights, fmt.Errorf("Could not read queue weights %s", err)
+	}
+	if err := json.Unmarshal(bz, &weights); err != nil {
+		return weights, fmt.Errorf("Could not decode queue weights %s", err)
+	}
+	return weights, nil
+}
+
+// WithQueue queues up to maxQueued jobs when all the proving slots are taken
+// instead of rejecting them. Queued jobs are started in weighted fair order
+// across clients, such that a client catching up with hundreds of proofs can't
+// starve the others.
+func WithQueue(maxQueued int, weights QueueWeights) Option {
+	return func(p *proverServer) {
+		p.queue = fairqueue.New[[32]byte, queuedJob](maxQueued, weights.Clients, weights.Default)
+	}
+}
+
+// ContextWithClientID attaches the authenticated identity of the caller, set by
+// an authentication interceptor. The principal authorized by the policy, or the
+// host of the caller, is used otherwise.
+func ContextWithClientID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, clientIDKey{}, id)
+}
+
+// Identity the jobs are queued fairly across.
+func clientID(ctx context.Context) string {
+	owner, authenticated := callerFromContext(ctx)
+	if authenticated {
+		return owner
+	}
+	if host, _, err := net.SplitHostPort(owner); err == nil {
+		return host
+	}
+	return owner
+}
+
+// Queue a job that couldn't get a proving slot, returning false if the queue
+// is full or disabled.
+func (p *proverServer) enqueue(client string, proveKey [32]byte, req *grpc.ProveRequest, reqJson []byte) bool {
+	if p.queue == nil || !p.queue.Push(client, proveKey, queuedJob{req, reqJson}) {
+		return false
+	}
+	// Captured by snapshots like the running jobs
+	p.requests.Store(proveKey, req)
+	log.Info().Hex("request_hash", proveKey[:]).Str("client", client).Int("queued", p.queue.Len()).Msg("queued")
+	// A slot may have been released in the meantime
+	p.dispatch()
+	return true
+}
+
+// Start the queued jobs while there are free proving slots, a job waiting for
+// the capacity of its circuit letting the jobs of the other circuits through.
+func (p *proverServer) dispatch() {
+	if p.queue == nil {
+		return
+	}
+	for {
+		proveKey, queued, found := p.queue.PopIf(func(proveKey [32]byte, queued queuedJob) bool {
+			return p.acquireJob(proveKey, queued.req.CircuitId)
+		})
+		if !found {
+			return
+		}
+		p.spawn(proveKey, queued.req, queued.reqJson)
+	}
+}
+
+// Remove a job from the queue, returning false if it isn't queued.
+func (p *proverServer) dequeue(proveKey [32]byte) bool {
+	if p.queue == nil || !p.queue.Remove(proveKey) {
+		return false
+	}
+	p.requests.Delete(proveKey)
+	return true
+}
+
+func (p *proverServer) queuedJobs() uint32 {
+	if p.queue == nil {
+		return 0
+	}
+	return uint32(p.queue.Len())
+}
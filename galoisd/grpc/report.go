@@ -0,0 +1,115 @@
+package grpc
+
+import (
+	"galois/pkg/lightclient"
+	"io"
+	"math"
+	"runtime"
+	"runtime/debug"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+// Size and loading time of an artifact.
+type artifactLoad struct {
+	bytes   int64
+	elapsed time.Duration
+}
+
+func (a *artifactLoad) record(readFrom func(io.Reader) (int64, error), r io.Reader) error {
+	start := time.Now()
+	read, err := readFrom(r)
+	if err != nil {
+		return err
+	}
+	a.bytes, a.elapsed = read, time.Since(start)
+	return nil
+}
+
+func (a artifactLoad) dict() *zerolog.Event {
+	return zerolog.Dict().Int64("bytes", a.bytes).Dur("elapsed", a.elapsed)
+}
+
+// How the circuit and its keys were loaded.
+type loadReport struct {
+	// Either storage, embedded or compiled
+	source string
+	r1cs   artifactLoad
+	pk     artifactLoad
+	vk     artifactLoad
+}
+
+// Version and VCS revision the binary was built from, if recorded.
+func buildVersion() (string, string, bool) {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "", "", false
+	}
+	var revision string
+	var modified bool
+	for _, setting := range info.Settings {
+		switch setting.Key {
+		case "vcs.revision":
+			revision = setting.Value
+		case "vcs.modified":
+			modified = setting.Value == "true"
+		}
+	}
+	return info.Main.Version, revision, modified
+}
+
+// Log everything needed to tell apart a misconfigured member of a fleet, in a
+// single structured event.
+func (p *proverServer) logStartupReport(settings map[string]string) {
+	version, revision, modified := buildVersion()
+	event := log.Info().
+		Str("version", version).
+		Str("revision", revision).
+		Bool("modified", modified).
+		Str("go_version", runtime.Version()).
+		Str("os", runtime.GOOS).
+		Str("arch", runtime.GOARCH).
+		Strs("cpu_features", cpuFeatures()).
+		Bool("accelerated_field_arithmetic", acceleratedFieldArithmetic).
+		Int("num_cpu", runtime.NumCPU()).
+		Int("max_procs", runtime.GOMAXPROCS(0)).
+		Uint32("max_jobs", p.maxJobs)
+
+	keys := p.current.Load()
+	event = event.
+		Hex("circuit_id", p.circuitID).
+		Str("circuit_profile", lightclient.Profile).
+		Int("max_validators", lightclient.MaxVal).
+		Str("curve", keys.cs.CurveID().String()).
+		Int("constraints", keys.cs.GetNbConstraints()).
+		Int("public_variables", keys.cs.GetNbPublicVariables()).
+		Int("secret_variables", keys.cs.GetNbSecretVariables()).
+		Str("source", keys.loaded.source).
+		Dict("r1cs", keys.loaded.r1cs.dict()).
+		Dict("pk", keys.loaded.pk.dict()).
+		Dict("vk", keys.loaded.vk.dict())
+	if circuitHash, vkHash, err := p.artifactHashes(); err != nil {
+		log.Warn().Err(err).Msg("Could not hash the artifacts")
+	} else {
+		event = event.Hex("circuit_hash", circuitHash).Hex("vk_hash", vkHash)
+	}
+	if previous := p.previous.Load(); previous != nil {
+		event = event.Hex("previous_circuit_id", previous.circuitID)
+	}
+
+	memory := zerolog.Dict()
+	if total, available, ok := systemMemory(); ok {
+		memory = memory.Uint64("total", total).Uint64("available", available)
+	}
+	if limit, ok := cgroupMemoryLimit(); ok {
+		memory = memory.Uint64("cgroup_limit", limit)
+	}
+	if limit := debug.SetMemoryLimit(-1); limit != math.MaxInt64 {
+		memory = memory.Int64("go_limit", limit)
+	}
+	event = event.Dict("memory", memory)
+
+	event.Dict("config", zerolog.Dict().Fields(settings)).Msg("Startup report")
+}
@@ -0,0 +1,66 @@
+package grpc
+
+import (
+	"bufio"
+	"bytes"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Total and available memory of the host in bytes.
+func systemMemory() (uint64, uint64, bool) {
+	f, err := os.Open("/proc/meminfo")
+	if err != nil {
+		return 0, 0, false
+	}
+	defer f.Close()
+	var total, available uint64
+	var found int
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		// e.g. MemAvailable:   12345678 kB
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 {
+			continue
+		}
+		value, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			continue
+		}
+		switch fields[0] {
+		case "MemTotal:":
+			total = value * 1024
+			found++
+		case "MemAvailable:":
+			available = value * 1024
+			found++
+		}
+	}
+	return total, available, found == 2
+}
+
+// Memory limit of the cgroup of the process in bytes, if any.
+func cgroupMemoryLimit() (uint64, bool) {
+	for _, path := range []string{
+		"/sys/fs/cgroup/memory.max",
+		"/sys/fs/cgroup/memory/memory.limit_in_bytes",
+	} {
+		bz, err := os.ReadFile(path)
+		if err != nil {
+			continue
+		}
+		// Unlimited with cgroup v2
+		value := string(bytes.TrimSpace(bz))
+		if value == "max" {
+			return 0, false
+		}
+		limit, err := strconv.ParseUint(value, 10, 64)
+		// Unlimited with cgroup v1, close to MaxInt64
+		if err != nil || limit >= 1<<62 {
+			return 0, false
+		}
+		return limit, true
+	}
+	return 0, false
+}
@@ -0,0 +1,11 @@
+//go:build !linux
+
+package grpc
+
+func systemMemory() (uint64, uint64, bool) {
+	return 0, 0, false
+}
+
+func cgroupMemoryLimit() (uint64, bool) {
+	return 0, false
+}
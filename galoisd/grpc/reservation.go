@@ -0,0 +1,267 @@
+package grpc
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Aliases of the circuits in a reservation file, resolved once the keys are
+// loaded.
+const (
+	reservationCurrent  = "current"
+	reservationPrevious = "previous"
+)
+
+// Capacity dedicated to a circuit, taken out of the capacity shared by all the
+// circuits.
+type Reservation struct {
+	// Proving slots only the circuit can use
+	Slots uint32 `json:"slots"`
+	// Bytes of the memory budget only the circuit can use
+	Memory uint64 `json:"memory"`
+	// Bytes a proof of the circuit is expected to take, jobs are only started
+	// if they fit in the memory budget. Not accounted if 0.
+	JobMemory uint64 `json:"job_memory"`
+	// Whether the circuit is restricted to its reserved capacity instead of
+	// also competing for the shared one.
+	Pinned bool `json:"pinned"`
+}
+
+// Reservations of the circuits served, such that a big circuit can't starve a
+// small one sharing the daemon.
+type Reservations struct {
+	// Bytes of memory the jobs may take, the memory isn't accounted if 0.
+	Memory uint64 `json:"memory"`
+	// By hex encoded circuit ID, or current and previous.
+	Circuits map[string]Reservation `json:"circuits"`
+}
+
+// Read reservations from a JSON file.
+func ReadReservations(path string) (Reservations, error) {
+	var reservations Reservations
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return reservations, fmt.Errorf("Could not read the reservations %s", err)
+	}
+	if err := json.Unmarshal(data, &reservations); err != nil {
+		return reservations, fmt.Errorf("Could not parse the reservations %s", err)
+	}
+	return reservations, nil
+}
+
+type circuitUsage struct {
+	slots  uint32
+	memory uint64
+}
+
+// What a job took, given back when it is done.
+type grant struct {
+	circuit string
+	// Whether the slot and memory are taken from the reservation of the
+	// circuit or from the shared capacity
+	reservedSlot   bool
+	reservedMemory bool
+	memory         uint64
+}
+
+// Admission of the jobs by circuit, resolved once the keys are loaded.
+type capacity struct {
+	config Reservations
+
+	mu sync.Mutex
+	// By hex encoded circuit ID
+	reservations map[string]Reservation
+	usage        map[string]*circuitUsage
+	sharedSlots  uint32
+	usedSlots    uint32
+	sharedMemory uint64
+	usedMemory   uint64
+	grants       map[[32]byte]grant
+}
+
+// WithReservations dedicates proving slots and memory to the circuits served.
+func WithReservations(reservations Reservations) Option {
+	return func(p *proverServer) {
+		p.capacity.config = reservations
+	}
+}
+
+func (p *proverServer) circuitKey(circuitID []byte) string {
+	if len(circuitID) == 0 {
+		circuitID = p.circuitID
+	}
+	return hex.EncodeToString(circuitID)
+}
+
+// Resolve the reservations against the circuits loaded, checking they fit in
+// the capacity.
+func (p *proverServer) resolveReservations() error {
+	c := &p.capacity
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.reservations = make(map[string]Reservation)
+	c.usage = make(map[string]*circuitUsage)
+	c.grants = make(map[[32]byte]grant)
+	c.sharedSlots, c.sharedMemory = p.maxJobs, c.config.Memory
+
+	served := map[string]bool{p.circuitKey(nil): true}
+	previous := p.previous.Load()
+	if previous != nil {
+		served[p.circuitKey(previous.circuitID)] = true
+	}
+	for name, reservation := range c.config.Circuits {
+		key := strings.ToLower(strings.TrimPrefix(name, "0x"))
+		switch name {
+		case reservationCurrent:
+			key = p.circuitKey(nil)
+		case reservationPrevious:
+			if previous == nil {
+				return fmt.Errorf("Reservation for the previous circuit but no rollover is configured")
+			}
+			key = p.circuitKey(previous.circuitID)
+		}
+		if !served[key] {
+			return fmt.Errorf("Reservation for circuit %s which isn't served", name)
+		}
+		if _, found := c.reservations[key]; found {
+			return fmt.Errorf("Circuit %s is reserved twice", name)
+		}
+		if reservation.Pinned && reservation.Slots == 0 {
+			return fmt.Errorf("Circuit %s is pinned without reserved slots", name)
+		}
+		if reservation.Slots > c.sharedSlots {
+			return fmt.Errorf("The reserved slots exceed the %d proving slots", p.maxJobs)
+		}
+		c.sharedSlots -= reservation.Slots
+		if c.config.Memory > 0 {
+			if reservation.Memory > c.sharedMemory {
+				return fmt.Errorf("The reserved memory exceeds the budget of %d bytes", c.config.Memory)
+			}
+			c.sharedMemory -= reservation.Memory
+		}
+		c.reservations[key] = reservation
+		c.usage[key] = &circuitUsage{}
+		log.Info().
+			Str("circuit_id", key).
+			Uint32("slots", reservation.Slots).
+			Uint64("memory", reservation.Memory).
+			Uint64("job_memory", reservation.JobMemory).
+			Bool("pinned", reservation.Pinned).
+			Msg("Reserved capacity")
+	}
+	for key, reservation := range c.reservations {
+		if c.config.Memory > 0 && reservation.JobMemory > max(reservation.Memory, c.sharedMemory) {
+			return fmt.Errorf("A job of circuit %s takes more memory than it can be given", key)
+		}
+	}
+	return nil
+}
+
+// Give the reservation of an unloaded circuit back to the shared capacity, its
+// running jobs now counting against the shared one.
+func (p *proverServer) releaseReservation(circuitID []byte) {
+	c := &p.capacity
+	key := p.circuitKey(circuitID)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	reservation, found := c.reservations[key]
+	if !found {
+		return
+	}
+	delete(c.reservations, key)
+	c.sharedSlots += reservation.Slots
+	if c.config.Memory > 0 {
+		c.sharedMemory += reservation.Memory
+	}
+	for proveKey, g := range c.grants {
+		if g.circuit != key {
+			continue
+		}
+		if g.reservedSlot {
+			c.usedSlots++
+		}
+		if g.reservedMemory {
+			c.usedMemory += g.memory
+		}
+		g.reservedSlot, g.reservedMemory = false, false
+		c.grants[proveKey] = g
+	}
+	delete(c.usage, key)
+}
+
+// Reserve a proving slot for a job, returning false if the server, or the
+// share of the circuit, is at capacity.
+func (p *proverServer) acquireJob(proveKey [32]byte, circuitID []byte) bool {
+	c := &p.capacity
+	key := p.circuitKey(circuitID)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	reservation := c.reservations[key]
+	usage := c.usage[key]
+	if usage == nil {
+		usage = &circuitUsage{}
+	}
+
+	g := grant{circuit: key, reservedSlot: usage.slots < reservation.Slots}
+	if !g.reservedSlot && (reservation.Pinned || c.usedSlots >= c.sharedSlots) {
+		return false
+	}
+	if c.config.Memory > 0 && reservation.JobMemory > 0 {
+		g.memory = reservation.JobMemory
+		g.reservedMemory = usage.memory+g.memory <= reservation.Memory
+		if !g.reservedMemory && (reservation.Pinned || c.usedMemory+g.memory > c.sharedMemory) {
+			return false
+		}
+	}
+
+	if g.reservedSlot {
+		usage.slots++
+	} else {
+		c.usedSlots++
+	}
+	if g.reservedMemory {
+		usage.memory += g.memory
+	} else {
+		c.usedMemory += g.memory
+	}
+	c.grants[proveKey] = g
+	p.nbJobs.Add(1)
+	return true
+}
+
+func (p *proverServer) releaseJob(proveKey [32]byte) {
+	c := &p.capacity
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	g, found := c.grants[proveKey]
+	if !found {
+		return
+	}
+	delete(c.grants, proveKey)
+	usage := c.usage[g.circuit]
+	if g.reservedSlot {
+		usage.slots--
+	} else {
+		c.usedSlots--
+	}
+	if g.reservedMemory {
+		usage.memory -= g.memory
+	} else {
+		c.usedMemory -= g.memory
+	}
+	p.nbJobs.Add(^uint32(0))
+}
+
+// Slots and memory reserved to a circuit.
+func (p *proverServer) reservationOf(circuitID []byte) Reservation {
+	c := &p.capacity
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.reservations[p.circuitKey(circuitID)]
+}
@@ -0,0 +1,259 @@
+package grpc
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	currentCircuit  = []byte{0xc0}
+	previousCircuit = []byte{0xc1}
+)
+
+func newReservedServer(t *testing.T, maxJobs uint32, rollover bool, reservations Reservations) *proverServer {
+	p := &proverServer{maxJobs: maxJobs, circuitID: currentCircuit}
+	if rollover {
+		p.previous.Store(&keyset{circuitID: previousCircuit})
+	}
+	WithReservations(reservations)(p)
+	require.NoError(t, p.resolveReservations())
+	return p
+}
+
+// A step of a reservation scenario, the jobs being numbered.
+type reservationStep struct {
+	acquire            []byte
+	release            bool
+	releaseReservation []byte
+	job                byte
+	// Whether the job is admitted, for acquire
+	admitted bool
+}
+
+func acquire(job byte, circuit []byte, admitted bool) reservationStep {
+	return reservationStep{acquire: circuit, job: job, admitted: admitted}
+}
+
+func release(job byte) reservationStep {
+	return reservationStep{release: true, job: job}
+}
+
+func unload(circuit []byte) reservationStep {
+	return reservationStep{releaseReservation: circuit}
+}
+
+func TestReservations(t *testing.T) {
+	cases := []struct {
+		name         string
+		maxJobs      uint32
+		rollover     bool
+		reservations Reservations
+		steps        []reservationStep
+	}{
+		{
+			name:    "shared slots",
+			maxJobs: 2,
+			steps: []reservationStep{
+				acquire(1, nil, true),
+				acquire(2, nil, true),
+				acquire(3, nil, false),
+				release(1),
+				acquire(3, nil, true),
+			},
+		},
+		{
+			name:     "reserved slot taken first",
+			maxJobs:  3,
+			rollover: true,
+			reservations: Reservations{Circuits: map[string]Reservation{
+				reservationCurrent: {Slots: 1},
+			}},
+			steps: []reservationStep{
+				// Reserved, then shared
+				acquire(1, currentCircuit, true),
+				acquire(2, currentCircuit, true),
+				acquire(3, previousCircuit, true),
+				acquire(4, previousCircuit, false),
+				// The reserved slot is only for the current circuit
+				release(1),
+				acquire(4, previousCircuit, false),
+				acquire(4, currentCircuit, true),
+			},
+		},
+		{
+			name:     "pinned circuit",
+			maxJobs:  3,
+			rollover: true,
+			reservations: Reservations{Circuits: map[string]Reservation{
+				reservationPrevious: {Slots: 1, Pinned: true},
+			}},
+			steps: []reservationStep{
+				acquire(1, previousCircuit, true),
+				// Shared slots are left but the circuit is pinned
+				acquire(2, previousCircuit, false),
+				acquire(2, currentCircuit, true),
+				acquire(3, currentCircuit, true),
+				acquire(4, currentCircuit, false),
+			},
+		},
+		{
+			name:     "memory budget",
+			maxJobs:  4,
+			rollover: true,
+			reservations: Reservations{Memory: 100, Circuits: map[string]Reservation{
+				reservationCurrent:  {Memory: 60, JobMemory: 60},
+				reservationPrevious: {JobMemory: 40},
+			}},
+			steps: []reservationStep{
+				acquire(1, currentCircuit, true),
+				// Neither the reserved nor the shared memory fit a second job
+				acquire(2, currentCircuit, false),
+				acquire(2, previousCircuit, true),
+				acquire(3, previousCircuit, false),
+				release(1),
+				acquire(3, currentCircuit, true),
+			},
+		},
+		{
+			name:     "unloaded circuit",
+			maxJobs:  2,
+			rollover: true,
+			reservations: Reservations{Memory: 100, Circuits: map[string]Reservation{
+				reservationPrevious: {Slots: 1, Memory: 50, JobMemory: 50, Pinned: true},
+			}},
+			steps: []reservationStep{
+				acquire(1, previousCircuit, true),
+				acquire(2, currentCircuit, true),
+				// The running job moves to the shared capacity along with the
+				// reservation, leaving no slot
+				unload(previousCircuit),
+				acquire(3, currentCircuit, false),
+				release(1),
+				acquire(3, currentCircuit, true),
+			},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			p := newReservedServer(t, c.maxJobs, c.rollover, c.reservations)
+			running := map[[32]byte]bool{}
+			for i, step := range c.steps {
+				key := [32]byte{step.job}
+				switch {
+				case step.acquire != nil:
+					assert.Equal(t, step.admitted, p.acquireJob(key, step.acquire), "step %d", i)
+					if step.admitted {
+						running[key] = true
+					}
+				case step.release:
+					p.releaseJob(key)
+					delete(running, key)
+				case step.releaseReservation != nil:
+					p.releaseReservation(step.releaseReservation)
+				}
+				assert.Equal(t, uint32(len(running)), p.nbJobs.Load(), "step %d", i)
+			}
+			for key := range running {
+				p.releaseJob(key)
+			}
+
+			// Everything is given back
+			assert.Zero(t, p.nbJobs.Load())
+			assert.Zero(t, p.capacity.usedSlots)
+			assert.Zero(t, p.capacity.usedMemory)
+			assert.Empty(t, p.capacity.grants)
+			for circuit, usage := range p.capacity.usage {
+				assert.Equal(t, circuitUsage{}, *usage, circuit)
+			}
+		})
+	}
+}
+
+func TestReleaseReservationSharesItsCapacity(t *testing.T) {
+	p := newReservedServer(t, 3, true, Reservations{Memory: 100, Circuits: map[string]Reservation{
+		reservationPrevious: {Slots: 2, Memory: 70},
+	}})
+	assert.Equal(t, uint32(1), p.capacity.sharedSlots)
+	assert.Equal(t, uint64(30), p.capacity.sharedMemory)
+
+	p.releaseReservation(previousCircuit)
+	assert.Equal(t, uint32(3), p.capacity.sharedSlots)
+	assert.Equal(t, uint64(100), p.capacity.sharedMemory)
+	assert.NotContains(t, p.capacity.reservations, hex.EncodeToString(previousCircuit))
+	// Releasing twice is a no-op
+	p.releaseReservation(previousCircuit)
+	assert.Equal(t, uint32(3), p.capacity.sharedSlots)
+}
+
+func TestInvalidReservations(t *testing.T) {
+	cases := []struct {
+		name         string
+		rollover     bool
+		reservations Reservations
+	}{
+		{
+			name: "pinned without slots",
+			reservations: Reservations{Circuits: map[string]Reservation{
+				reservationCurrent: {Pinned: true},
+			}},
+		},
+		{
+			name: "more slots than the server",
+			reservations: Reservations{Circuits: map[string]Reservation{
+				reservationCurrent: {Slots: 3},
+			}},
+		},
+		{
+			name:     "slots reserved twice over",
+			rollover: true,
+			reservations: Reservations{Circuits: map[string]Reservation{
+				reservationCurrent:  {Slots: 1},
+				reservationPrevious: {Slots: 2},
+			}},
+		},
+		{
+			name: "more memory than the budget",
+			reservations: Reservations{Memory: 100, Circuits: map[string]Reservation{
+				reservationCurrent: {Memory: 101},
+			}},
+		},
+		{
+			name: "job larger than its memory",
+			reservations: Reservations{Memory: 100, Circuits: map[string]Reservation{
+				reservationCurrent: {JobMemory: 101},
+			}},
+		},
+		{
+			name: "unknown circuit",
+			reservations: Reservations{Circuits: map[string]Reservation{
+				"0xdeadbeef": {Slots: 1},
+			}},
+		},
+		{
+			name: "previous circuit without rollover",
+			reservations: Reservations{Circuits: map[string]Reservation{
+				reservationPrevious: {Slots: 1},
+			}},
+		},
+		{
+			name: "circuit reserved twice",
+			reservations: Reservations{Circuits: map[string]Reservation{
+				reservationCurrent:                        {Slots: 1},
+				"0x" + hex.EncodeToString(currentCircuit): {Slots: 1},
+			}},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			p := &proverServer{maxJobs: 2, circuitID: currentCircuit}
+			if c.rollover {
+				p.previous.Store(&keyset{circuitID: previousCircuit})
+			}
+			WithReservations(c.reservations)(p)
+			assert.Error(t, p.resolveReservations())
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package grpc
+
+import (
+	context "context"
+	grpc "galois/grpc/api/v3"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/rs/zerolog/log"
+)
+
+var evictedResultsCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "galoisd",
+	Subsystem: "prover",
+	Name:      "evicted_results_total",
+	Help:      "Number of results of finished jobs evicted, by reason.",
+}, []string{"reason"})
+
+// How long the results of the finished jobs are kept. A proof is useless once
+// the chain has been proven at a later height, the light client having been
+// updated past it.
+type retention struct {
+	// Disabled if 0
+	maxAge time.Duration
+	// Disabled if negative
+	heightWindow int64
+
+	mu sync.Mutex
+	// Highest height proven per chain
+	heights map[string]int64
+}
+
+// WithResultRetention evicts the results of the jobs finished more than maxAge
+// ago, and of the proofs at least heightWindow heights below the highest one
+// proven for the same chain. A zero age or negative window disables the
+// corresponding eviction. The expired results are evicted periodically while
+// Serve runs.
+func WithResultRetention(maxAge time.Duration, heightWindow int64) Option {
+	return func(p *proverServer) {
+		p.retention = &retention{
+			maxAge:       maxAge,
+			heightWindow: heightWindow,
+			heights:      make(map[string]int64),
+		}
+	}
+}
+
+func (r *retention) provenHeight(chainID string) (int64, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	height, found := r.heights[chainID]
+	return height, found
+}
+
+// Record the height of a completed proof, evicting the proofs it supersedes.
+func (p *proverServer) recordProvenHeight(req *grpc.ProveRequest) {
+	if p.retention == nil || p.retention.heightWindow < 0 {
+		return
+	}
+	r := p.retention
+	r.mu.Lock()
+	chainID, height := req.GetVote().GetChainID(), req.GetUntrustedHeader().GetHeight()
+	superseding := height > r.heights[chainID]
+	if superseding {
+		r.heights[chainID] = height
+	}
+	r.mu.Unlock()
+	if superseding {
+		p.evictResults()
+	}
+}
+
+// The reason a finished job should be evicted, if any.
+func (p *proverServer) evictionReason(j *job, now time.Time) string {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	r := p.retention
+	if j.finishedAt.IsZero() {
+		return ""
+	}
+	if r.maxAge > 0 && now.Sub(j.finishedAt) > r.maxAge {
+		return "expired"
+	}
+	if r.heightWindow >= 0 && j.chainID != "" {
+		if proven, found := r.provenHeight(j.chainID); found && proven-j.height > r.heightWindow {
+			return "superseded"
+		}
+	}
+	return ""
+}
+
+func (p *proverServer) evictResults() {
+	now := time.Now()
+	p.results.Range(func(key, value any) bool {
+		if _, pending := value.(*grpc.ProveRequestPending); pending {
+			return true
+		}
+		j, found := p.jobs.Load(key)
+		if !found {
+			return true
+		}
+		reason := p.evictionReason(j.(*job), now)
+		if reason == "" {
+			return true
+		}
+		// The job may have been submitted again in the meantime
+		if p.results.CompareAndDelete(key, value) {
+			p.jobs.Delete(key)
+			evictedResultsCounter.WithLabelValues(reason).Inc()
+			proveKey := key.([32]byte)
+			log.Debug().Hex("request_hash", proveKey[:]).Str("reason", reason).Msg("evict")
+		}
+		return true
+	})
+}
+
+// Periodically evict the expired results until the context is done.
+func (p *proverServer) watchRetention(ctx context.Context) {
+	interval := min(max(p.retention.maxAge/4, time.Second), time.Minute)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			p.evictResults()
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package grpc
+
+import (
+	context "context"
+	grpc "galois/grpc/api/v3"
+	"testing"
+	"time"
+
+	tmtypes "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func retentionRequest(chainID string, height int64) *grpc.ProveRequest {
+	return &grpc.ProveRequest{
+		Vote:            &tmtypes.CanonicalVote{ChainID: chainID, Height: height},
+		UntrustedHeader: &tmtypes.Header{ChainID: chainID, Height: height},
+	}
+}
+
+// Track a job of the request, finished at the given time unless zero, with its
+// result.
+func trackRetainedJob(p *proverServer, key byte, req *grpc.ProveRequest, finishedAt time.Time, result any) [32]byte {
+	proveKey := [32]byte{key}
+	p.trackJob(proveKey, "relayer", false, "", req)
+	p.updateJob(proveKey, func(j *job) {
+		j.finishedAt = finishedAt
+	})
+	p.results.Store(proveKey, result)
+	return proveKey
+}
+
+func assertRetained(t *testing.T, p *proverServer, proveKey [32]byte, retained bool) {
+	t.Helper()
+	_, found := p.results.Load(proveKey)
+	assert.Equal(t, retained, found)
+	_, found = p.jobs.Load(proveKey)
+	assert.Equal(t, retained, found)
+}
+
+func TestRetentionExpiry(t *testing.T) {
+	p := &proverServer{}
+	WithResultRetention(time.Hour, -1)(p)
+	now := time.Now()
+
+	expired := trackRetainedJob(p, 1, retentionRequest("union", 10), now.Add(-2*time.Hour), &grpc.ProveResponse{})
+	failed := trackRetainedJob(p, 2, retentionRequest("union", 11), now.Add(-2*time.Hour), errJobCancelled)
+	recent := trackRetainedJob(p, 3, retentionRequest("union", 12), now.Add(-time.Minute), &grpc.ProveResponse{})
+	pending := trackRetainedJob(p, 4, retentionRequest("union", 13), time.Time{}, &grpc.ProveRequestPending{})
+
+	value, _ := p.jobs.Load(expired)
+	assert.Equal(t, "expired", p.evictionReason(value.(*job), now))
+	value, _ = p.jobs.Load(recent)
+	assert.Equal(t, "", p.evictionReason(value.(*job), now))
+
+	p.evictResults()
+	assertRetained(t, p, expired, false)
+	assertRetained(t, p, failed, false)
+	assertRetained(t, p, recent, true)
+	assertRetained(t, p, pending, true)
+}
+
+func TestRetentionSupersededHeights(t *testing.T) {
+	p := &proverServer{}
+	WithResultRetention(0, 2)(p)
+	now := time.Now()
+
+	old := trackRetainedJob(p, 1, retentionRequest("union", 10), now, &grpc.ProveResponse{})
+	window := trackRetainedJob(p, 2, retentionRequest("union", 11), now, &grpc.ProveResponse{})
+	otherChain := trackRetainedJob(p, 3, retentionRequest("osmosis", 1), now, &grpc.ProveResponse{})
+	// Pending jobs are never evicted, even below the proven height
+	pending := trackRetainedJob(p, 4, retentionRequest("union", 5), time.Time{}, &grpc.ProveRequestPending{})
+
+	p.recordProvenHeight(retentionRequest("union", 12))
+	assertRetained(t, p, old, true)
+	assertRetained(t, p, window, true)
+
+	// A superseding proof evicts right away
+	p.recordProvenHeight(retentionRequest("union", 13))
+	assert.Equal(t, "superseded", p.evictionReason(&job{finishedAt: now, chainID: "union", height: 10}, now))
+	assertRetained(t, p, old, false)
+	assertRetained(t, p, window, true)
+	assertRetained(t, p, otherChain, true)
+	assertRetained(t, p, pending, true)
+
+	// Lower heights don't lower the proven one
+	p.recordProvenHeight(retentionRequest("union", 1))
+	height, _ := p.retention.provenHeight("union")
+	assert.Equal(t, int64(13), height)
+}
+
+func TestRetentionDisabledHeights(t *testing.T) {
+	p := &proverServer{}
+	WithResultRetention(time.Hour, -1)(p)
+	p.recordProvenHeight(retentionRequest("union", 12))
+	_, found := p.retention.provenHeight("union")
+	assert.False(t, found)
+}
+
+func TestWatchRetentionStops(t *testing.T) {
+	p := &proverServer{}
+	WithResultRetention(time.Hour, -1)(p)
+	ctx, cancel := context.WithCancel(context.Background())
+	stopped := make(chan struct{})
+	go func() {
+		p.watchRetention(ctx)
+		close(stopped)
+	}()
+	cancel()
+	select {
+	case <-stopped:
+	case <-time.After(time.Second):
+		t.Fatal("The retention kept running once the context was done")
+	}
+}
@@ -0,0 +1,271 @@
+package grpc
+
+import (
+	context "context"
+	"errors"
+	grpc "galois/grpc/api/v3"
+	"galois/pkg/listener"
+	"galois/pkg/sandbox"
+	"galois/pkg/storage"
+	"galois/pkg/tlsreload"
+	"net"
+	"net/http"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/rs/zerolog/log"
+	ggrpc "google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/keepalive"
+)
+
+// Config of a prover daemon started through Serve.
+type Config struct {
+	// Addresses to listen on, ignored if Listeners is set.
+	Network   string
+	Addresses []string
+	// Already bound listeners, e.g. on a random port in tests.
+	Listeners []net.Listener
+	// Admission of the incoming connections. MaxConn also bounds the number
+	// of concurrent proofs.
+	Accept listener.Config
+	// Detection of the clients holding a connection slot while sending their
+	// requests too slowly.
+	SlowClients listener.SlowClientConfig
+	// Address of the admin service, as host:port or unix:path, ignored if
+	// AdminListener is set. The admin service is only served on this listener,
+	// along with the public API, and not served at all if both are empty.
+	AdminAddr     string
+	AdminListener net.Listener
+
+	R1CSPath string
+	PKPath   string
+	VKPath   string
+	// Defaults to the working directory.
+	Storage storage.Backend
+	// Restored on startup and saved on shutdown if set.
+	SnapshotPath string
+
+	// TLS is disabled if empty.
+	TLSCert           string
+	TLSKey            string
+	TLSReloadInterval time.Duration
+	// The certificate is reloaded whenever a value is received, e.g. on SIGHUP.
+	TLSReload <-chan struct{}
+
+	// Address of the Prometheus endpoint, disabled if empty.
+	MetricsAddr string
+
+	// Applied once the keys are loaded and the listeners bound if set.
+	Sandbox *sandbox.Config
+
+	// Effective settings, e.g. the command line flags, echoed in the startup
+	// report.
+	Settings map[string]string
+
+	// Bound of the requests carrying a commit, derived from the circuit if 0
+	MaxRequestBytes int
+	ServerOptions   []ggrpc.ServerOption
+	ProverOptions   []Option
+	// Called right before accepting connections, additional services can be
+	// registered on the public server. Returning an error aborts the startup.
+	OnStart []func(*ggrpc.Server) error
+	// Called after the server stopped serving.
+	OnStop []func()
+}
+
+// Serve the prover API until the context is cancelled, at which point the
+// server gracefully stops.
+func Serve(ctx context.Context, cfg Config) error {
+	listeners := cfg.Listeners
+	if len(listeners) == 0 {
+		var err error
+		listeners, err = listener.ListenAll(cfg.Network, cfg.Addresses)
+		if err != nil {
+			return err
+		}
+	}
+	for _, lis := range listeners {
+		log.Info().Str("network", lis.Addr().Network()).Str("addr", lis.Addr().String()).Msg("Listening")
+	}
+	adminLis := cfg.AdminListener
+	if adminLis == nil && cfg.AdminAddr != "" {
+		var err error
+		adminLis, err = listenAdmin(cfg.AdminAddr)
+		if err != nil {
+			return err
+		}
+	}
+	if adminLis != nil {
+		defer adminLis.Close()
+		log.Info().Str("network", adminLis.Addr().Network()).Str("addr", adminLis.Addr().String()).Msg("Listening for the admin service")
+	}
+	limitedLis := listener.New(listener.Merge(listeners...), cfg.Accept)
+	defer limitedLis.Close()
+	tracker := listener.NewTracker(cfg.SlowClients)
+	trackedLis := tracker.Wrap(limitedLis)
+	go tracker.Watch(ctx, time.Second)
+
+	if cfg.MetricsAddr != "" {
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", promhttp.Handler())
+		metricsServer := &http.Server{Addr: cfg.MetricsAddr, Handler: mux}
+		go func() {
+			if err := metricsServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				log.Error().Str("addr", cfg.MetricsAddr).Err(err).Msg("Metrics server failed")
+			}
+		}()
+		defer metricsServer.Close()
+	}
+
+	maxRequestBytes := cfg.MaxRequestBytes
+	if maxRequestBytes <= 0 {
+		maxRequestBytes = MaxRequestSize()
+	}
+	log.Info().Int("max_request_bytes", maxRequestBytes).Msg("Bounding requests")
+	codec := newSizeCodec(maxRequestBytes)
+	// The rejections of the codec are returned before any other interceptor
+	// sees the requests.
+	serverOptions := append([]ggrpc.ServerOption{
+		ggrpc.KeepaliveParams(keepalive.ServerParameters{
+			MaxConnectionIdle:     10 * time.Second,
+			MaxConnectionAge:      5 * time.Minute,
+			MaxConnectionAgeGrace: time.Second,
+			Time:                  5 * time.Second,
+			Timeout:               20 * time.Second,
+		}),
+		ggrpc.ChainUnaryInterceptor(codec.UnaryServerInterceptor()),
+		ggrpc.ChainStreamInterceptor(codec.StreamServerInterceptor()),
+	}, cfg.ServerOptions...)
+	serverOptions = append(serverOptions, ggrpc.ForceServerCodecV2(codec))
+	// The certificate is reloaded periodically and on demand, rotating it
+	// doesn't require restarting (and reloading the proving key).
+	var reloader *tlsreload.Reloader
+	if cfg.TLSCert != "" {
+		var err error
+		reloader, err = tlsreload.New(cfg.TLSCert, cfg.TLSKey)
+		if err != nil {
+			return err
+		}
+		if cfg.TLSReloadInterval > 0 {
+			go reloader.Watch(ctx, cfg.TLSReloadInterval)
+		}
+		serverOptions = append(serverOptions, ggrpc.Creds(credentials.NewTLS(reloader.Config())))
+	}
+	// The admin connections bypass the shaping and tracking of the public ones.
+	grpcServer := ggrpc.NewServer(append(serverOptions, ggrpc.StatsHandler(&connStatsHandler{tracker: tracker}))...)
+	var adminServer *ggrpc.Server
+	if adminLis != nil {
+		adminServer = ggrpc.NewServer(serverOptions...)
+		defer adminServer.Stop()
+	}
+
+	store := cfg.Storage
+	if store == nil {
+		store = storage.NewFilesystem("")
+	}
+	healthServer := health.NewServer()
+	proverOptions := append([]Option{WithStorage(store), WithHealthServer(healthServer), withConnections(tracker)}, cfg.ProverOptions...)
+	server, err := NewProverServer(uint32(cfg.Accept.MaxConn), cfg.R1CSPath, cfg.PKPath, cfg.VKPath, proverOptions...)
+	if err != nil {
+		return err
+	}
+	grpc.RegisterUnionProverAPIServer(grpcServer, server)
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+	// The public API is served along with the admin service such that
+	// operators only need the admin address.
+	if adminServer != nil {
+		grpc.RegisterUnionProverAPIServer(adminServer, server)
+		grpc.RegisterUnionProverAdminAPIServer(adminServer, server)
+		healthpb.RegisterHealthServer(adminServer, healthServer)
+	}
+	healthServer.SetServingStatus(grpc.UnionProverAPI_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_SERVING)
+	if cfg.SnapshotPath != "" {
+		if exists, err := store.Exists(ctx, cfg.SnapshotPath); err == nil && exists {
+			snapshot, err := ReadSnapshot(ctx, store, cfg.SnapshotPath)
+			if err != nil {
+				return err
+			}
+			server.Restore(snapshot)
+		}
+	}
+	server.logStartupReport(cfg.Settings)
+	if server.retention != nil && server.retention.maxAge > 0 {
+		go server.watchRetention(ctx)
+	}
+	for _, hook := range cfg.OnStart {
+		if err := hook(grpcServer); err != nil {
+			return err
+		}
+	}
+	// Everything that required privileges or arbitrary files is done.
+	if cfg.Sandbox != nil {
+		if err := sandbox.Apply(*cfg.Sandbox); err != nil {
+			return err
+		}
+		log.Info().Strs("data_dirs", cfg.Sandbox.ReadWrite).Msg("Sandboxed")
+	}
+	defer func() {
+		for _, hook := range cfg.OnStop {
+			hook()
+		}
+	}()
+
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		for {
+			select {
+			case <-cfg.TLSReload:
+				if reloader != nil {
+					reloader.ReloadAndLog()
+				}
+			case <-ctx.Done():
+				log.Info().Msg("Shutting down...")
+				healthServer.Shutdown()
+				if adminServer != nil {
+					adminServer.GracefulStop()
+				}
+				grpcServer.GracefulStop()
+				return
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	log.Info().
+		Str("arch", runtime.GOARCH).
+		Strs("cpu_features", cpuFeatures()).
+		Bool("accelerated_field_arithmetic", acceleratedFieldArithmetic).
+		Msg("Serving...")
+	if adminServer != nil {
+		go func() {
+			if err := adminServer.Serve(adminLis); err != nil && !errors.Is(err, ggrpc.ErrServerStopped) {
+				log.Error().Err(err).Msg("Admin server failed")
+			}
+		}()
+	}
+	err = grpcServer.Serve(trackedLis)
+	if cfg.SnapshotPath != "" {
+		if err := WriteSnapshot(context.Background(), store, cfg.SnapshotPath, server.Snapshot()); err != nil {
+			log.Error().Str("path", cfg.SnapshotPath).Err(err).Msg("Could not save snapshot")
+		} else {
+			log.Info().Str("path", cfg.SnapshotPath).Msg("Snapshot saved")
+		}
+	}
+	return err
+}
+
+// Listen on a TCP address or, prefixed with unix:, a unix socket, the form
+// gRPC clients dial.
+func listenAdmin(addr string) (net.Listener, error) {
+	if path, found := strings.CutPrefix(addr, "unix:"); found {
+		return net.Listen("unix", path)
+	}
+	return net.Listen("tcp", addr)
+}
@@ -6,13 +6,19 @@ import (
 	context "context"
 	"crypto/sha256"
 	"encoding/json"
+	"errors"
 	"fmt"
 	grpc "galois/grpc/api/v3"
+	"galois/pkg/apierror"
+	"galois/pkg/artifacts"
+	"galois/pkg/fairqueue"
 	"galois/pkg/lightclient"
 	lcgadget "galois/pkg/lightclient/nonadjacent"
+	"galois/pkg/listener"
+	"galois/pkg/storage"
+	"galois/pkg/verify"
 	"io"
 	"math/big"
-	"os"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -34,16 +40,68 @@ import (
 	gadget "github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
 
 	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/health"
 )
 
 type proverServer struct {
 	grpc.UnimplementedUnionProverAPIServer
-	cs      cs_bn254.R1CS
-	pk      backend_bn254.ProvingKey
-	vk      backend_bn254.VerifyingKey
-	maxJobs uint32
-	nbJobs  atomic.Uint32
-	results sync.Map
+	grpc.UnimplementedUnionProverAdminAPIServer
+	// The current circuit and keys, replaced when reloaded
+	current atomic.Pointer[keyset]
+	// ID of the current circuit, unchanged by a reload
+	circuitID []byte
+	// Where the current circuit and keys are loaded from
+	r1csPath string
+	pkPath   string
+	vkPath   string
+	reloadMu sync.Mutex
+	// Keys of the previous circuit during a rollover
+	previous atomic.Pointer[keyset]
+	rollover *rollover
+	maxJobs  uint32
+	nbJobs   atomic.Uint32
+	// Proving slots and memory by circuit
+	capacity capacity
+	results  sync.Map
+	// Requests of the jobs currently being proven
+	requests sync.Map
+	// Bookkeeping (owner, timings) of the jobs
+	jobs sync.Map
+	// Cancellation functions of the jobs currently being proven
+	cancels sync.Map
+	// Jobs waiting for a proving slot, disabled if nil
+	queue *fairqueue.Queue[[32]byte, queuedJob]
+	// Jobs of the authenticated clients
+	tenants tenantQuotas
+	// Eviction of the finished jobs, kept forever if nil
+	retention *retention
+	// Rejection of the stale requests, disabled if nil
+	freshness *freshness
+	clock     *clockMonitor
+	// Background verification of the proofs, disabled if nil
+	audit *audit
+
+	proveTime proveTime
+
+	onProveStart []ProveStartHook
+	onProveDone  []ProveDoneHook
+
+	// Where the circuit, keys and proofs are stored
+	storage        storage.Backend
+	proofOutputDir string
+	inputsDir      string
+
+	crossCheck *crossCheck
+
+	maintenance maintenance
+	health      *health.Server
+	// Statistics of the client connections, unknown if nil
+	connections *listener.Tracker
+
+	hashesOnce  sync.Once
+	circuitHash []byte
+	vkHash      []byte
+	hashesErr   error
 }
 
 type cometblsHashToField struct {
@@ -127,212 +185,344 @@ func AggregateSignatures(signatures [][]byte) (bn254.G2Affine, error) {
 	return aggregatedSignature, nil
 }
 
-func (p *proverServer) Poll(ctx context.Context, pollReq *grpc.PollRequest) (*grpc.PollResponse, error) {
-	req := pollReq.Request
+// A field of the public input commitment, as encoded before being hashed.
+type inputField struct {
+	name  string
+	value []byte
+}
 
-	if len(req.TrustedCommit.Validators) > lightclient.MaxVal {
-		return nil, fmt.Errorf("The circuit can handle a maximum of %d validators", lightclient.MaxVal)
+// Encode the block inputs to be verified, in the order they are hashed.
+func inputFields(chainID string, h *types.Header, trustedValidatorsHash []byte) []inputField {
+	i64 := func(x int64) []byte {
+		var padded [32]byte
+		big.NewInt(x).FillBytes(padded[:])
+		return padded[:]
 	}
-	if len(req.UntrustedCommit.Validators) > lightclient.MaxVal {
-		return nil, fmt.Errorf("The circuit can handle a maximum of %d validators", lightclient.MaxVal)
+	mimcHash := func(b []byte) []byte {
+		var padded [32]byte
+		big.NewInt(0).SetBytes(b).FillBytes(padded[:])
+		return padded[:]
 	}
-	if len(req.TrustedCommit.Signatures) > len(req.TrustedCommit.Signatures) {
-		return nil, fmt.Errorf("More signatures than validators")
+	return []inputField{
+		{"chain_id", mimcHash([]byte(chainID))},
+		{"height", i64(h.Height)},
+		{"time_seconds", i64(h.Time.Unix())},
+		{"time_nanoseconds", i64(int64(h.Time.Nanosecond()))},
+		{"validators_hash", mimcHash(h.ValidatorsHash)},
+		{"next_validators_hash", mimcHash(h.NextValidatorsHash)},
+		{"app_hash", h.AppHash},
+		{"trusted_validators_hash", mimcHash(trustedValidatorsHash)},
 	}
-	if len(req.UntrustedCommit.Signatures) > len(req.UntrustedCommit.Signatures) {
-		return nil, fmt.Errorf("More signatures than validators")
+}
+
+func hashInputFields(fields []inputField) []byte {
+	buff := []byte{}
+	for _, field := range fields {
+		buff = append(buff, field.value...)
 	}
+	hash := sha256.Sum256(buff)
+	return hash[1:]
+}
 
-	reqJson, err := json.Marshal(req)
+// Compute the sole public input of the circuit, a truncated sha256 of the block inputs to be verified.
+func InputsHash(chainID string, h *types.Header, trustedValidatorsHash []byte) []byte {
+	return hashInputFields(inputFields(chainID, h, trustedValidatorsHash))
+}
+
+func (p *proverServer) prove(ctx context.Context, proveKey [32]byte, req *grpc.ProveRequest) (*grpc.ProveResponse, error) {
+	start := time.Now()
+	keys, err := p.keysFor(req.CircuitId)
 	if err != nil {
 		return nil, err
 	}
-	proveKey := sha256.Sum256(reqJson)
 
-	prove := func() (*grpc.ProveResponse, error) {
+	log.Debug().Msg("Marshaling trusted validators...")
+	trustedValidators, trustedValidatorsRoot, err := MarshalValidators(req.TrustedCommit.Validators)
+	if err != nil {
+		return nil, fmt.Errorf("Could not marshal trusted validators %s", err)
+	}
 
-		log.Debug().Msg("Marshaling trusted validators...")
-		trustedValidators, trustedValidatorsRoot, err := MarshalValidators(req.TrustedCommit.Validators)
-		if err != nil {
-			return nil, fmt.Errorf("Could not marshal trusted validators %s", err)
-		}
+	log.Debug().Msg("Aggregating trusted signature...")
+	trustedAggregatedSignature, err := AggregateSignatures(req.TrustedCommit.Signatures)
+	if err != nil {
+		return nil, fmt.Errorf("Could not aggregate trusted signature %s", err)
+	}
 
-		log.Debug().Msg("Aggregating trusted signature...")
-		trustedAggregatedSignature, err := AggregateSignatures(req.TrustedCommit.Signatures)
-		if err != nil {
-			return nil, fmt.Errorf("Could not aggregate trusted signature %s", err)
-		}
+	log.Debug().Msg("Marshaling untrusted validators...")
+	untrustedValidators, _, err := MarshalValidators(req.UntrustedCommit.Validators)
+	if err != nil {
+		return nil, fmt.Errorf("Could not marshal untrusted validators %s", err)
+	}
 
-		log.Debug().Msg("Marshaling untrusted validators...")
-		untrustedValidators, _, err := MarshalValidators(req.UntrustedCommit.Validators)
-		if err != nil {
-			return nil, fmt.Errorf("Could not marshal untrusted validators %s", err)
-		}
+	log.Debug().Msg("Aggregating untrusted signature...")
+	untrustedAggregatedSignature, err := AggregateSignatures(req.UntrustedCommit.Signatures)
+	if err != nil {
+		return nil, fmt.Errorf("Could not aggregate untrusted signature %s", err)
+	}
 
-		log.Debug().Msg("Aggregating untrusted signature...")
-		untrustedAggregatedSignature, err := AggregateSignatures(req.UntrustedCommit.Signatures)
-		if err != nil {
-			return nil, fmt.Errorf("Could not aggregate untrusted signature %s", err)
-		}
+	trustedInput := lcgadget.TendermintNonAdjacentLightClientInput{
+		Sig:           gadget.NewG2Affine(trustedAggregatedSignature),
+		Validators:    trustedValidators,
+		NbOfVal:       len(req.TrustedCommit.Validators),
+		NbOfSignature: len(req.TrustedCommit.Signatures),
+		Bitmap:        new(big.Int).SetBytes(req.TrustedCommit.Bitmap),
+	}
 
-		trustedInput := lcgadget.TendermintNonAdjacentLightClientInput{
-			Sig:           gadget.NewG2Affine(trustedAggregatedSignature),
-			Validators:    trustedValidators,
-			NbOfVal:       len(req.TrustedCommit.Validators),
-			NbOfSignature: len(req.TrustedCommit.Signatures),
-			Bitmap:        new(big.Int).SetBytes(req.TrustedCommit.Bitmap),
-		}
+	untrustedInput := lcgadget.TendermintNonAdjacentLightClientInput{
+		Sig:           gadget.NewG2Affine(untrustedAggregatedSignature),
+		Validators:    untrustedValidators,
+		NbOfVal:       len(req.UntrustedCommit.Validators),
+		NbOfSignature: len(req.UntrustedCommit.Signatures),
+		Bitmap:        new(big.Int).SetBytes(req.UntrustedCommit.Bitmap),
+	}
 
-		untrustedInput := lcgadget.TendermintNonAdjacentLightClientInput{
-			Sig:           gadget.NewG2Affine(untrustedAggregatedSignature),
-			Validators:    untrustedValidators,
-			NbOfVal:       len(req.UntrustedCommit.Validators),
-			NbOfSignature: len(req.UntrustedCommit.Signatures),
-			Bitmap:        new(big.Int).SetBytes(req.UntrustedCommit.Bitmap),
+	uncons := func(b []byte) lightclient.UnconsHash {
+		return lightclient.UnconsHash{
+			Head: b[0],
+			Tail: b[1:],
 		}
+	}
 
-		uncons := func(b []byte) lightclient.UnconsHash {
-			return lightclient.UnconsHash{
-				Head: b[0],
-				Tail: b[1:],
-			}
-		}
+	inputsHash := InputsHash(req.Vote.ChainID, req.UntrustedHeader, trustedValidatorsRoot)
 
-		getInputsHash := func(chainID string, h *types.Header, trustedValidatorsHash []byte) []byte {
-			buff := []byte{}
-			var padded [32]byte
-			writeI64 := func(x int64) {
-				big.NewInt(x).FillBytes(padded[:])
-				buff = append(buff, padded[:]...)
-			}
-			writeMiMCHash := func(b []byte) {
-				big.NewInt(0).SetBytes(b).FillBytes(padded[:])
-				buff = append(buff, padded[:]...)
-			}
-			writeHash := func(b []byte) {
-				buff = append(buff, b...)
-			}
-			writeMiMCHash([]byte(chainID))
-			writeI64(h.Height)
-			writeI64(h.Time.Unix())
-			writeI64(int64(h.Time.Nanosecond()))
-			writeMiMCHash(h.ValidatorsHash)
-			writeMiMCHash(h.NextValidatorsHash)
-			writeHash(h.AppHash)
-			writeMiMCHash(trustedValidatorsHash)
-			hash := sha256.Sum256(buff)
-			return hash[1:]
-		}
+	log.Debug().Hex("request_hash", proveKey[:]).Hex("inputs_hash", inputsHash).Send()
 
-		inputsHash := getInputsHash(req.Vote.ChainID, req.UntrustedHeader, trustedValidatorsRoot)
-
-		log.Debug().Hex("request_hash", proveKey[:]).Hex("inputs_hash", inputsHash).Send()
-
-		witness := lcgadget.Circuit{
-			DomainSeparationTag: []byte(cometbn254.CometblsSigDST),
-			TrustedInput:        trustedInput,
-			TrustedValRoot:      trustedValidatorsRoot,
-			UntrustedInput:      untrustedInput,
-			Vote: lightclient.BlockVote{
-				BlockPartSetHeaderTotal: req.Vote.BlockID.PartSetHeader.Total,
-				BlockPartSetHeaderHash:  uncons(req.Vote.BlockID.PartSetHeader.Hash),
-				Round:                   req.Vote.Round,
-			},
-			Header: lightclient.BlockHeader{
-				VersionBlock:                req.UntrustedHeader.Version.Block,
-				VersionApp:                  req.UntrustedHeader.Version.App,
-				ChainID:                     []byte(req.UntrustedHeader.ChainID),
-				Height:                      req.UntrustedHeader.Height,
-				TimeSecs:                    req.UntrustedHeader.Time.Unix(),
-				TimeNanos:                   req.UntrustedHeader.Time.Nanosecond(),
-				LastBlockHash:               req.UntrustedHeader.LastBlockId.Hash,
-				LastBlockPartSetHeaderTotal: req.UntrustedHeader.LastBlockId.PartSetHeader.Total,
-				LastBlockPartSetHeaderHash:  uncons(req.UntrustedHeader.LastBlockId.PartSetHeader.Hash),
-				LastCommitHash:              uncons(req.UntrustedHeader.LastCommitHash),
-				DataHash:                    uncons(req.UntrustedHeader.DataHash),
-				ValidatorsHash:              req.UntrustedHeader.ValidatorsHash,
-				NextValidatorsHash:          req.UntrustedHeader.NextValidatorsHash,
-				ConsensusHash:               uncons(req.UntrustedHeader.ConsensusHash),
-				AppHash:                     uncons(req.UntrustedHeader.AppHash),
-				LastResultsHash:             uncons(req.UntrustedHeader.LastResultsHash),
-				EvidenceHash:                uncons(req.UntrustedHeader.EvidenceHash),
-				ProposerAddress:             uncons(req.UntrustedHeader.ProposerAddress),
-			},
-			InputsHash: inputsHash,
-		}
+	p.updateJob(proveKey, func(j *job) {
+		j.inputsHash = inputsHash
+	})
 
-		privateWitness, err := frontend.NewWitness(&witness, ecc.BN254.ScalarField())
-		if err != nil {
-			return nil, fmt.Errorf("Could not create witness %s", err)
-		}
+	witness := lcgadget.Circuit{
+		DomainSeparationTag: []byte(cometbn254.CometblsSigDST),
+		TrustedInput:        trustedInput,
+		TrustedValRoot:      trustedValidatorsRoot,
+		UntrustedInput:      untrustedInput,
+		Vote: lightclient.BlockVote{
+			BlockPartSetHeaderTotal: req.Vote.BlockID.PartSetHeader.Total,
+			BlockPartSetHeaderHash:  uncons(req.Vote.BlockID.PartSetHeader.Hash),
+			Round:                   req.Vote.Round,
+		},
+		Header: lightclient.BlockHeader{
+			VersionBlock:                req.UntrustedHeader.Version.Block,
+			VersionApp:                  req.UntrustedHeader.Version.App,
+			ChainID:                     []byte(req.UntrustedHeader.ChainID),
+			Height:                      req.UntrustedHeader.Height,
+			TimeSecs:                    req.UntrustedHeader.Time.Unix(),
+			TimeNanos:                   req.UntrustedHeader.Time.Nanosecond(),
+			LastBlockHash:               req.UntrustedHeader.LastBlockId.Hash,
+			LastBlockPartSetHeaderTotal: req.UntrustedHeader.LastBlockId.PartSetHeader.Total,
+			LastBlockPartSetHeaderHash:  uncons(req.UntrustedHeader.LastBlockId.PartSetHeader.Hash),
+			LastCommitHash:              uncons(req.UntrustedHeader.LastCommitHash),
+			DataHash:                    uncons(req.UntrustedHeader.DataHash),
+			ValidatorsHash:              req.UntrustedHeader.ValidatorsHash,
+			NextValidatorsHash:          req.UntrustedHeader.NextValidatorsHash,
+			ConsensusHash:               uncons(req.UntrustedHeader.ConsensusHash),
+			AppHash:                     uncons(req.UntrustedHeader.AppHash),
+			LastResultsHash:             uncons(req.UntrustedHeader.LastResultsHash),
+			EvidenceHash:                uncons(req.UntrustedHeader.EvidenceHash),
+			ProposerAddress:             uncons(req.UntrustedHeader.ProposerAddress),
+		},
+		InputsHash: inputsHash,
+	}
 
-		log.Debug().Hex("request_hash", proveKey[:]).Msg("proving")
-		proof, err := backend.Prove(constraint.R1CS(&p.cs), backend.ProvingKey(&p.pk), privateWitness, backend_opts.WithProverHashToFieldFunction(&cometblsHashToField{}))
-		if err != nil {
-			return nil, fmt.Errorf("Prover failed with %s", err)
-		}
+	privateWitness, err := frontend.NewWitness(&witness, ecc.BN254.ScalarField())
+	if err != nil {
+		return nil, fmt.Errorf("Could not create witness %s", err)
+	}
 
-		publicWitness, err := privateWitness.Public()
-		if err != nil {
-			return nil, fmt.Errorf("Could not extract public inputs from witness %s", err)
-		}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
 
-		var proofCommitment []byte
-		var commitmentPOK []byte
-		switch _proof := proof.(type) {
-		case *backend_bn254.Proof:
-			if len(p.vk.PublicAndCommitmentCommitted) != 1 {
-				return nil, fmt.Errorf("Expected a single proof commitment, got: %d", len(p.vk.PublicAndCommitmentCommitted))
-			}
-			proofCommitment = _proof.Commitments[0].Marshal()
-			commitmentPOK = _proof.CommitmentPok.Marshal()
-			break
-		default:
-			return nil, fmt.Errorf("Impossible: proof backend must be BN254 at this point")
-		}
+	log.Debug().Hex("request_hash", proveKey[:]).Msg("proving")
+	proof, err := backend.Prove(
+		constraint.R1CS(&keys.cs),
+		backend.ProvingKey(&keys.pk),
+		privateWitness,
+		backend_opts.WithProverHashToFieldFunction(&cometblsHashToField{}),
+		backend_opts.WithSolverOptions(cancellableHints(ctx)...),
+	)
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	var unsatisfied *cs_bn254.UnsatisfiedConstraintError
+	if errors.As(err, &unsatisfied) {
+		return nil, apierror.New(apierror.ErrUnsatisfied, "Prover failed with %s", err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Prover failed with %s", err)
+	}
 
-		publicInputs, err := publicWitness.MarshalBinary()
-		if err != nil {
-			return nil, fmt.Errorf("Could not marshal public witness %s", err)
+	publicWitness, err := privateWitness.Public()
+	if err != nil {
+		return nil, fmt.Errorf("Could not extract public inputs from witness %s", err)
+	}
+
+	var proofCommitment []byte
+	var commitmentPOK []byte
+	switch _proof := proof.(type) {
+	case *backend_bn254.Proof:
+		if len(keys.vk.PublicAndCommitmentCommitted) != 1 {
+			return nil, fmt.Errorf("Expected a single proof commitment, got: %d", len(keys.vk.PublicAndCommitmentCommitted))
 		}
+		proofCommitment = _proof.Commitments[0].Marshal()
+		commitmentPOK = _proof.CommitmentPok.Marshal()
+		break
+	default:
+		return nil, fmt.Errorf("Impossible: proof backend must be BN254 at this point")
+	}
+
+	publicInputs, err := publicWitness.MarshalBinary()
+	if err != nil {
+		return nil, fmt.Errorf("Could not marshal public witness %s", err)
+	}
 
-		var proofBuffer bytes.Buffer
-		mem := bufio.NewWriter(&proofBuffer)
-		_, err = proof.WriteRawTo(mem)
+	var proofBuffer bytes.Buffer
+	mem := bufio.NewWriter(&proofBuffer)
+	_, err = proof.WriteRawTo(mem)
+	if err != nil {
+		return nil, err
+	}
+	mem.Flush()
+	proofBz := proofBuffer.Bytes()
+
+	var compressedProofBuffer bytes.Buffer
+	mem = bufio.NewWriter(&compressedProofBuffer)
+	_, err = proof.WriteTo(mem)
+	if err != nil {
+		return nil, err
+	}
+	mem.Flush()
+	compressedProofBz := compressedProofBuffer.Bytes()
+
+	// Due to how gnark proves, we not only need the ZKP A/B/C points, but also a commitment hash and proof commitment.
+	// The proof is an uncompressed proof serialized by gnark, we extract A(G1)/B(G2)/C(G1) and then append the commitment and its POK.
+	// The EVM verifier has been extended to support this two extra public inputs.
+	evmProof := append(append(proofBz[:256], proofCommitment...), commitmentPOK...)
+
+	proveRes := grpc.ProveResponse{
+		Proof: &grpc.ZeroKnowledgeProof{
+			Content:           proofBz,
+			CompressedContent: compressedProofBz,
+			PublicInputs:      publicInputs,
+			EvmProof:          evmProof,
+		},
+		TrustedValidatorSetRoot: trustedValidatorsRoot,
+	}
+	if req.InputsCommitmentScheme != grpc.InputsCommitmentScheme_INPUTS_COMMITMENT_SCHEME_UNSPECIFIED {
+		proveRes.InputsCommitment, err = commitPublicInputs(req.InputsCommitmentScheme, publicWitness)
 		if err != nil {
 			return nil, err
 		}
-		mem.Flush()
-		proofBz := proofBuffer.Bytes()
+	}
+
+	keys.proofs.record(time.Since(start))
+	return &proveRes, nil
+}
+
+// Compute the proof in the background, the caller must have acquired a job slot.
+func (p *proverServer) spawn(proveKey [32]byte, req *grpc.ProveRequest, reqJson []byte) {
+	ctx, cancel := context.WithCancel(context.Background())
+	p.requests.Store(proveKey, req)
+	p.cancels.Store(proveKey, cancel)
+	go func() {
+		defer cancel()
+		for _, hook := range p.onProveStart {
+			hook(proveKey[:])
+		}
+		start := time.Now()
+		p.updateJob(proveKey, func(j *job) {
+			j.startedAt = start
+		})
+		proveRes, err := p.prove(ctx, proveKey, req)
+		p.updateJob(proveKey, func(j *job) {
+			j.finishedAt = time.Now()
+		})
+		for _, hook := range p.onProveDone {
+			hook(proveKey[:], time.Since(start), err)
+		}
+		if ctx.Err() != nil {
+			log.Info().Str("action", "prove").Hex("request_hash", proveKey[:]).Msg("cancelled")
+			p.results.Store(proveKey, errJobCancelled)
+		} else if err != nil {
+			log.Error().Str("action", "prove").Hex("request_hash", proveKey[:]).RawJSON("request", reqJson).Err(err).Send()
+			p.results.Store(proveKey, fmt.Errorf("failed to generate proof: %w", err))
+		} else {
+			p.proveTime.record(time.Since(start))
+			observeProof(proveRes.Proof)
+			resJson, _ := json.Marshal(proveRes)
+			log.Info().Str("action", "prove").Hex("request_hash", proveKey[:]).RawJSON("request", reqJson).RawJSON("response", resJson).Send()
+			p.persistProof(proveKey, req, proveRes)
+			p.crossCheckProof(proveKey, req, proveRes)
+			p.results.Store(proveKey, proveRes)
+			p.recordProvenHeight(req)
+		}
+		p.cancels.Delete(proveKey)
+		p.requests.Delete(proveKey)
+		p.releaseTenantJob(proveKey)
+		p.releaseJob(proveKey)
+		p.dispatch()
+	}()
+}
 
-		var compressedProofBuffer bytes.Buffer
-		mem = bufio.NewWriter(&compressedProofBuffer)
-		_, err = proof.WriteTo(mem)
-		if err != nil {
-			return nil, err
+// Reject the requests the circuit cannot prove before they are admitted.
+func (p *proverServer) validateRequest(req *grpc.ProveRequest) error {
+	if req.Vote == nil || req.UntrustedHeader == nil || req.TrustedCommit == nil || req.UntrustedCommit == nil {
+		return apierror.New(apierror.ErrInvalidRequest, "Missing vote, untrusted header or commit")
+	}
+	for _, commit := range []*grpc.ValidatorSetCommit{req.TrustedCommit, req.UntrustedCommit} {
+		if len(commit.Validators) > lightclient.MaxVal {
+			return apierror.New(apierror.ErrInvalidRequest, "The circuit can handle a maximum of %d validators", lightclient.MaxVal)
 		}
-		mem.Flush()
-		compressedProofBz := compressedProofBuffer.Bytes()
-
-		// Due to how gnark proves, we not only need the ZKP A/B/C points, but also a commitment hash and proof commitment.
-		// The proof is an uncompressed proof serialized by gnark, we extract A(G1)/B(G2)/C(G1) and then append the commitment and its POK.
-		// The EVM verifier has been extended to support this two extra public inputs.
-		evmProof := append(append(proofBz[:256], proofCommitment...), commitmentPOK...)
-
-		proveRes := grpc.ProveResponse{
-			Proof: &grpc.ZeroKnowledgeProof{
-				Content:           proofBz,
-				CompressedContent: compressedProofBz,
-				PublicInputs:      publicInputs,
-				EvmProof:          evmProof,
-			},
-			TrustedValidatorSetRoot: trustedValidatorsRoot,
+		if len(commit.Signatures) > len(commit.Validators) {
+			return apierror.New(apierror.ErrInvalidRequest, "More signatures than validators")
 		}
+	}
+	if _, err := p.keysFor(req.CircuitId); err != nil {
+		return err
+	}
+	if req.InputsCommitmentScheme != grpc.InputsCommitmentScheme_INPUTS_COMMITMENT_SCHEME_UNSPECIFIED {
+		if _, err := commitmentHash(req.InputsCommitmentScheme); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Hash of a request, the hash of its JSON encoding. The key of the request in
+// the results is the hash in the namespace of its tenant.
+func requestKey(req *grpc.ProveRequest) ([32]byte, []byte, error) {
+	reqJson, err := json.Marshal(req)
+	if err != nil {
+		return [32]byte{}, nil, err
+	}
+	return sha256.Sum256(reqJson), reqJson, nil
+}
+
+func (p *proverServer) Poll(ctx context.Context, pollReq *grpc.PollRequest) (*grpc.PollResponse, error) {
+	req, err := pollRequest(pollReq)
+	if err != nil {
+		return nil, err
+	}
 
-		return &proveRes, nil
+	if err := p.validateRequest(req); err != nil {
+		return nil, err
 	}
 
+	requestHash, reqJson, err := requestKey(req)
+	if err != nil {
+		return nil, err
+	}
+	tenant := tenantFromContext(ctx)
+	proveKey := tenantKey(tenant, requestHash)
+
 	result, found := p.results.LoadOrStore(proveKey, &grpc.ProveRequestPending{})
+	// A cancelled job is submitted again
+	if found && result == errJobCancelled {
+		found = !p.results.CompareAndSwap(proveKey, result, &grpc.ProveRequestPending{})
+		if found {
+			result, _ = p.results.Load(proveKey)
+		}
+	}
 	if found {
 		log.Debug().Hex("request_hash", proveKey[:]).Msg("poll")
 
@@ -356,44 +546,37 @@ func (p *proverServer) Poll(ctx context.Context, pollReq *grpc.PollRequest) (*gr
 				Result: &grpc.PollResponse_Failed{
 					Failed: &grpc.ProveRequestFailed{
 						Message: _result.Error(),
+						Reason:  apierror.Reason(_result),
 					},
 				},
 			}, nil
 		}
 	} else {
-		log.Info().Hex("request_hash", proveKey[:]).Msg("new")
-
-		for true {
-			nbJobs := p.nbJobs.Load()
-			if nbJobs >= p.maxJobs {
-				p.results.Delete(proveKey)
-				return nil, fmt.Errorf("busy_building")
-			} else {
-				if swapped := p.nbJobs.CompareAndSwap(nbJobs, nbJobs+1); swapped {
-					break
-				}
-			}
-			time.Sleep(10 * time.Millisecond)
-		}
+		log.Info().Hex("request_hash", proveKey[:]).Str("tenant", tenant).Msg("new")
 
-		go func() {
-			proveRes, err := prove()
-			if err != nil {
-				log.Error().Str("action", "prove").Hex("request_hash", proveKey[:]).RawJSON("request", reqJson).Err(err).Send()
-				p.results.Store(proveKey, fmt.Errorf("failed to generate proof: %v", err))
-			} else {
-				resJson, _ := json.Marshal(proveRes)
-				log.Info().Str("action", "prove").Hex("request_hash", proveKey[:]).RawJSON("request", reqJson).RawJSON("response", resJson).Send()
-				p.results.Store(proveKey, proveRes)
-			}
-			for true {
-				value := p.nbJobs.Load()
-				if swapped := p.nbJobs.CompareAndSwap(value, value-1); swapped {
-					break
-				}
-				time.Sleep(10 * time.Millisecond)
-			}
-		}()
+		if end, draining := p.drainingUntil(); draining {
+			p.results.Delete(proveKey)
+			return nil, drainingError(end)
+		}
+		if err := p.checkFreshness(clientID(ctx), req); err != nil {
+			p.results.Delete(proveKey)
+			return nil, err
+		}
+		if !p.tenants.acquire(tenant, false) {
+			p.results.Delete(proveKey)
+			return nil, apierror.New(apierror.ErrQuotaExceeded, "Tenant %s has %d jobs running or queued", tenant, p.tenants.maxJobs)
+		}
+		owner, authenticated := callerFromContext(ctx)
+		p.trackJob(proveKey, owner, authenticated, tenant, req)
+		if p.acquireJob(proveKey, req.CircuitId) {
+			p.spawn(proveKey, req, reqJson)
+		} else if !p.enqueue(clientID(ctx), proveKey, req, reqJson) {
+			p.jobs.Delete(proveKey)
+			p.results.Delete(proveKey)
+			p.tenants.release(tenant)
+			return nil, p.busyError()
+		}
+		observeRequest(req)
 	}
 
 	return &grpc.PollResponse{
@@ -406,32 +589,25 @@ func (p *proverServer) Poll(ctx context.Context, pollReq *grpc.PollRequest) (*gr
 func (p *proverServer) Verify(ctx context.Context, req *grpc.VerifyRequest) (*grpc.VerifyResponse, error) {
 	log.Debug().Msg("Verifying...")
 
-	var proof backend_bn254.Proof
-	_, err := proof.ReadFrom(bytes.NewReader(req.Proof.CompressedContent))
+	reqJson, err := json.Marshal(req)
 	if err != nil {
-		return nil, fmt.Errorf("Failed to read compressed proof: %w", err)
-	}
-
-	witness := lcgadget.Circuit{
-		InputsHash: req.InputsHash,
+		return nil, err
 	}
 
-	publicWitness, err := frontend.NewWitness(&witness, ecc.BN254.ScalarField(), frontend.PublicOnly())
+	proof, err := verify.ReadProof(bytes.NewReader(req.Proof.CompressedContent))
 	if err != nil {
-		return nil, fmt.Errorf("Unable to create private witness: %w", err)
+		return nil, apierror.New(apierror.ErrInvalidRequest, "Failed to read compressed proof: %s", err)
 	}
 
-	reqJson, err := json.Marshal(req)
+	keys, err := p.keysFor(req.CircuitId)
 	if err != nil {
 		return nil, err
 	}
 
-	err = backend.Verify(
-		backend.Proof(&proof),
-		backend.VerifyingKey(&p.vk),
-		publicWitness,
-		backend_opts.WithVerifierHashToFieldFunction(&cometblsHashToField{}),
-	)
+	var inputsHash fr.Element
+	inputsHash.SetBytes(req.InputsHash)
+
+	err = verify.Verify(keys.verifier, proof, []fr.Element{inputsHash})
 
 	if err != nil {
 		log.Error().RawJSON("request", reqJson).Hex("inputs_hash", req.InputsHash).Str("action", "verify").Err(err).Send()
@@ -451,7 +627,7 @@ func (p *proverServer) GenerateContract(ctx context.Context, req *grpc.GenerateC
 
 	var buffer bytes.Buffer
 	mem := bufio.NewWriter(&buffer)
-	err := p.vk.ExportSolidity(mem)
+	err := p.current.Load().vk.ExportSolidity(mem)
 	if err != nil {
 		return nil, err
 	}
@@ -464,23 +640,24 @@ func (p *proverServer) GenerateContract(ctx context.Context, req *grpc.GenerateC
 
 func (p *proverServer) QueryStats(ctx context.Context, req *grpc.QueryStatsRequest) (*grpc.QueryStatsResponse, error) {
 	log.Debug().Msg("Querying stats...")
+	keys := p.current.Load()
 
 	return &grpc.QueryStatsResponse{
 		VariableStats: &grpc.VariableStats{
-			NbInternalVariables: uint32(p.cs.GetNbInternalVariables()),
-			NbSecretVariables:   uint32(p.cs.GetNbSecretVariables()),
-			NbPublicVariables:   uint32(p.cs.GetNbPublicVariables()),
-			NbConstraints:       uint32(p.cs.GetNbConstraints()),
-			NbCoefficients:      uint32(p.cs.GetNbCoefficients()),
+			NbInternalVariables: uint32(keys.cs.GetNbInternalVariables()),
+			NbSecretVariables:   uint32(keys.cs.GetNbSecretVariables()),
+			NbPublicVariables:   uint32(keys.cs.GetNbPublicVariables()),
+			NbConstraints:       uint32(keys.cs.GetNbConstraints()),
+			NbCoefficients:      uint32(keys.cs.GetNbCoefficients()),
 		},
 		ProvingKeyStats: &grpc.ProvingKeyStats{
-			NbG1: uint32(p.pk.NbG1()),
-			NbG2: uint32(p.pk.NbG2()),
+			NbG1: uint32(keys.pk.NbG1()),
+			NbG2: uint32(keys.pk.NbG2()),
 		},
 		VerifyingKeyStats: &grpc.VerifyingKeyStats{
-			NbG1:            uint32(p.vk.NbG1()),
-			NbG2:            uint32(p.vk.NbG2()),
-			NbPublicWitness: uint32(p.vk.NbPublicWitness()),
+			NbG1:            uint32(keys.vk.NbG1()),
+			NbG2:            uint32(keys.vk.NbG2()),
+			NbPublicWitness: uint32(keys.vk.NbPublicWitness()),
 		},
 		// Deprecated
 		CommitmentStats: &grpc.CommitmentStats{
@@ -497,13 +674,13 @@ func (p *proverServer) Prove(ctx context.Context, req *grpc.ProveRequest) (*grpc
 			Request: req,
 		})
 		if err != nil {
-			return nil, fmt.Errorf("%v", err)
+			return nil, err
 		}
 		if done := pollRes.GetDone(); done != nil {
 			return done.Response, nil
 		}
 		if failed := pollRes.GetFailed(); failed != nil {
-			return nil, fmt.Errorf("%v", failed.Message)
+			return nil, apierror.FromFailed(failed)
 		}
 		time.Sleep(1 * time.Second)
 	}
@@ -511,81 +688,132 @@ func (p *proverServer) Prove(ctx context.Context, req *grpc.ProveRequest) (*grpc
 	panic("impossible; qed;")
 }
 
-func loadOrCreate(r1csPath string, pkPath string, vkPath string) (cs_bn254.R1CS, backend_bn254.ProvingKey, backend_bn254.VerifyingKey, error) {
+func loadKeys(store storage.Backend, r1csPath string, pkPath string, vkPath string, report *loadReport) (cs_bn254.R1CS, backend_bn254.ProvingKey, backend_bn254.VerifyingKey, error) {
 	cs := cs_bn254.R1CS{}
 	pk := backend_bn254.ProvingKey{}
 	vk := backend_bn254.VerifyingKey{}
 
-	if _, err := os.Stat(r1csPath); err == nil {
-		if _, err = os.Stat(pkPath); err == nil {
-			if _, err = os.Stat(vkPath); err == nil {
-				log.Info().Msg("Loading circuit...")
-
-				log.Debug().Msg("Loading R1CS...")
-				err := readFrom(r1csPath, constraint.R1CS(&cs))
-				if err != nil {
-					return cs, pk, vk, err
-				}
-
-				log.Debug().Msg("Loading proving key...")
-				err = readFrom(pkPath, backend.ProvingKey(&pk))
-				if err != nil {
-					return cs, pk, vk, err
-				}
-
-				log.Debug().Msg("Loading verifying key...")
-				err = readFrom(vkPath, backend.VerifyingKey(&vk))
-				if err != nil {
-					return cs, pk, vk, err
-				}
-
-				var commitmentKeyBytes bytes.Buffer
-				mem := bufio.NewWriter(&commitmentKeyBytes)
-				_, err = vk.CommitmentKey.WriteRawTo(mem)
-				if err != nil {
-					return cs, pk, vk, err
-				}
-				mem.Flush()
-				commitmentKey := commitmentKeyBytes.Bytes()
-
-				log.Debug().
-					Str("alpha", vk.G1.Alpha.String()).
-					Str("beta", vk.G1.Beta.String()).
-					Str("gamma", vk.G2.Gamma.String()).
-					Str("delta", vk.G2.Delta.String()).
-					Hex("pedersen", commitmentKey).
-					Msg("verifying_key")
-
-				return cs, pk, vk, nil
+	log.Info().Msg("Loading circuit...")
+
+	report.source = "storage"
+
+	log.Debug().Msg("Loading R1CS...")
+	err := readFrom(store, r1csPath, constraint.R1CS(&cs), &report.r1cs)
+	if err != nil {
+		return cs, pk, vk, err
+	}
+
+	log.Debug().Msg("Loading proving key...")
+	err = readFrom(store, pkPath, backend.ProvingKey(&pk), &report.pk)
+	if err != nil {
+		return cs, pk, vk, err
+	}
+
+	log.Debug().Msg("Loading verifying key...")
+	err = readFrom(store, vkPath, backend.VerifyingKey(&vk), &report.vk)
+	if err != nil {
+		return cs, pk, vk, err
+	}
+
+	var commitmentKeyBytes bytes.Buffer
+	mem := bufio.NewWriter(&commitmentKeyBytes)
+	_, err = vk.CommitmentKey.WriteRawTo(mem)
+	if err != nil {
+		return cs, pk, vk, err
+	}
+	mem.Flush()
+	commitmentKey := commitmentKeyBytes.Bytes()
+
+	log.Debug().
+		Str("alpha", vk.G1.Alpha.String()).
+		Str("beta", vk.G1.Beta.String()).
+		Str("gamma", vk.G2.Gamma.String()).
+		Str("delta", vk.G2.Delta.String()).
+		Hex("pedersen", commitmentKey).
+		Msg("verifying_key")
+
+	return cs, pk, vk, nil
+}
+
+func loadOrCreate(store storage.Backend, r1csPath string, pkPath string, vkPath string, report *loadReport) (cs_bn254.R1CS, backend_bn254.ProvingKey, backend_bn254.VerifyingKey, error) {
+	cs := cs_bn254.R1CS{}
+	pk := backend_bn254.ProvingKey{}
+	vk := backend_bn254.VerifyingKey{}
+
+	if exists, err := store.Exists(context.Background(), r1csPath); err == nil && exists {
+		if exists, err = store.Exists(context.Background(), pkPath); err == nil && exists {
+			if exists, err = store.Exists(context.Background(), vkPath); err == nil && exists {
+				return loadKeys(store, r1csPath, pkPath, vkPath, report)
 			}
 		}
 	}
 
+	if artifacts.HasCircuit() {
+		log.Info().Msg("Loading embedded circuit...")
+
+		report.source = "embedded"
+		embeddedR1CS, embeddedPK := artifacts.Circuit()
+		if err := report.r1cs.record(cs.ReadFrom, bytes.NewReader(embeddedR1CS)); err != nil {
+			return cs, pk, vk, fmt.Errorf("Could not read embedded R1CS %s", err)
+		}
+		if err := report.pk.record(pk.ReadFrom, bytes.NewReader(embeddedPK)); err != nil {
+			return cs, pk, vk, fmt.Errorf("Could not read embedded proving key %s", err)
+		}
+		if err := report.vk.record(vk.ReadFrom, bytes.NewReader(artifacts.VerifyingKey())); err != nil {
+			return cs, pk, vk, fmt.Errorf("Could not read embedded verifying key %s", err)
+		}
+		return cs, pk, vk, nil
+	}
+
+	return generateKeys(store, r1csPath, pkPath, vkPath, report)
+}
+
+// GenerateKeys compiles the circuit and generates its keys with a single party
+// setup, whose toxic waste is known to this process. This is only meant for
+// development and tests, production keys come from the ceremony.
+func GenerateKeys(store storage.Backend, r1csPath string, pkPath string, vkPath string) error {
+	var report loadReport
+	_, _, _, err := generateKeys(store, r1csPath, pkPath, vkPath, &report)
+	return err
+}
+
+func generateKeys(store storage.Backend, r1csPath string, pkPath string, vkPath string, report *loadReport) (cs_bn254.R1CS, backend_bn254.ProvingKey, backend_bn254.VerifyingKey, error) {
+	cs := cs_bn254.R1CS{}
+	pk := backend_bn254.ProvingKey{}
+	vk := backend_bn254.VerifyingKey{}
+
 	var circuit lcgadget.Circuit
 
+	// The elapsed times are the compilation and setup ones
+	report.source = "compiled"
+	start := time.Now()
+
 	log.Info().Msg("Compiling circuit...")
 	r1csInstance, err := frontend.Compile(ecc.BN254.ScalarField(), r1cs.NewBuilder, &circuit, frontend.WithCompressThreshold(300))
 	if err != nil {
 		return cs, pk, vk, err
 	}
+	report.r1cs.elapsed = time.Since(start)
 
 	cs = *r1csInstance.(*cs_bn254.R1CS)
 
 	log.Debug().Msg("Setup PK/VK")
+	start = time.Now()
 	err = backend_bn254.Setup(&cs, &pk, &vk)
 	if err != nil {
 		return cs, pk, vk, err
 	}
+	report.pk.elapsed = time.Since(start)
 
-	err = saveTo(r1csPath, r1csInstance)
+	report.r1cs.bytes, err = saveTo(store, r1csPath, r1csInstance)
 	if err != nil {
 		return cs, pk, vk, err
 	}
-	err = saveTo(pkPath, backend.ProvingKey(&pk))
+	report.pk.bytes, err = saveTo(store, pkPath, backend.ProvingKey(&pk))
 	if err != nil {
 		return cs, pk, vk, err
 	}
-	err = saveTo(vkPath, backend.VerifyingKey(&vk))
+	report.vk.bytes, err = saveTo(store, vkPath, backend.VerifyingKey(&vk))
 	if err != nil {
 		return cs, pk, vk, err
 	}
@@ -610,38 +838,66 @@ func loadOrCreate(r1csPath string, pkPath string, vkPath string) (cs_bn254.R1CS,
 	return cs, pk, vk, nil
 }
 
-func NewProverServer(maxJobs uint32, r1csPath string, pkPath string, vkPath string) (*proverServer, error) {
-	cs, pk, vk, err := loadOrCreate(r1csPath, pkPath, vkPath)
+func NewProverServer(maxJobs uint32, r1csPath string, pkPath string, vkPath string, opts ...Option) (*proverServer, error) {
+	server := &proverServer{
+		maxJobs:   maxJobs,
+		storage:   storage.NewFilesystem(""),
+		inputsDir: defaultInputsDir,
+		r1csPath:  r1csPath,
+		pkPath:    pkPath,
+		vkPath:    vkPath,
+	}
+	for _, opt := range opts {
+		opt(server)
+	}
+
+	var report loadReport
+	cs, pk, vk, err := loadOrCreate(server.storage, r1csPath, pkPath, vkPath, &report)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := newKeyset(cs, pk, vk)
 	if err != nil {
 		return nil, err
 	}
+	keys.loaded = report
+	server.current.Store(keys)
+	server.circuitID = keys.circuitID
+	log.Info().Hex("circuit_id", server.circuitID).Msg("Serving circuit")
 
-	return &proverServer{cs: cs, pk: pk, vk: vk, maxJobs: maxJobs}, nil
+	if server.rollover != nil {
+		if err := server.loadPreviousKeys(); err != nil {
+			return nil, err
+		}
+	}
+	if err := server.resolveReservations(); err != nil {
+		return nil, err
+	}
+	if server.clock != nil {
+		go server.watchClock()
+	}
+	if server.audit != nil {
+		go server.watchProofs()
+	}
+	return server, nil
 }
 
-func readFrom(file string, obj io.ReaderFrom) error {
-	f, err := os.OpenFile(file, os.O_RDONLY, os.ModePerm)
+func readFrom(store storage.Backend, file string, obj io.ReaderFrom, load *artifactLoad) error {
+	start := time.Now()
+	read, err := storage.ReadFrom(context.Background(), store, file, obj)
 	if err != nil {
-		return err
+		return fmt.Errorf("Could not read %s: %s", file, err)
 	}
-	defer f.Close()
-	obj.ReadFrom(f)
+	load.bytes, load.elapsed = read, time.Since(start)
 	return nil
 }
 
-func saveTo(file string, x io.WriterTo) error {
+func saveTo(store storage.Backend, file string, x io.WriterTo) (int64, error) {
 	log.Debug().Str("path", file).Msg("saving")
-	f, err := os.Create(file)
-	if err != nil {
-		return err
-	}
-	defer f.Close()
-	w := bufio.NewWriter(f)
-	written, err := x.WriteTo(w)
+	written, err := storage.SaveTo(context.Background(), store, file, x)
 	if err != nil {
-		return err
+		return written, err
 	}
 	log.Debug().Str("path", file).Int64("bytes", written).Msg("saved")
-	w.Flush()
-	return nil
+	return written, nil
 }
@@ -0,0 +1,43 @@
+package grpc
+
+import (
+	grpc "galois/grpc/api/v3"
+	"galois/grpc/client"
+	"testing"
+	"time"
+
+	tmtypes "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// The clients cancel their jobs by the hash they compute, which must be the key
+// of the request on the server.
+func TestRequestKeyMatchesClientRequestHash(t *testing.T) {
+	req := &grpc.ProveRequest{
+		Vote: &tmtypes.CanonicalVote{
+			Type:    tmtypes.PrecommitType,
+			Height:  42,
+			Round:   1,
+			ChainID: "union-testnet",
+		},
+		UntrustedHeader: &tmtypes.Header{
+			ChainID: "union-testnet",
+			Height:  42,
+			Time:    time.Unix(1700000000, 123).UTC(),
+			AppHash: []byte{1, 2, 3},
+		},
+		TrustedCommit: &grpc.ValidatorSetCommit{
+			Validators: []*tmtypes.SimpleValidator{{VotingPower: 10}},
+			Signatures: [][]byte{{4, 5, 6}},
+			Bitmap:     []byte{1},
+		},
+		CircuitId: []byte("circuit"),
+	}
+
+	key, _, err := requestKey(req)
+	require.NoError(t, err)
+	hash, err := client.RequestHash(req)
+	require.NoError(t, err)
+	assert.Equal(t, key[:], hash)
+}
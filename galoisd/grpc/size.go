@@ -0,0 +1,296 @@
+package grpc
+
+import (
+	"context"
+	grpc "galois/grpc/api/v3"
+	"galois/pkg/apierror"
+	"galois/pkg/lightclient"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	cryptoproto "github.com/cometbft/cometbft/api/cometbft/crypto/v1"
+	tmtypes "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	version "github.com/cometbft/cometbft/api/cometbft/version/v1"
+	cometbn254 "github.com/cometbft/cometbft/crypto/bn254"
+	"github.com/cometbft/cometbft/types"
+	curve "github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/rs/zerolog/log"
+	ggrpc "google.golang.org/grpc"
+	"google.golang.org/grpc/encoding"
+	protocodec "google.golang.org/grpc/encoding/proto"
+	"google.golang.org/grpc/mem"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+var (
+	requestBytesHistogram = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "galoisd",
+		Subsystem: "prover",
+		Name:      "request_bytes",
+		Help:      "Size in bytes of the encoded requests carrying a commit, before decoding.",
+		Buckets:   prometheus.ExponentialBuckets(1024, 2, 12),
+	}, []string{"message"})
+	oversizedRequestsCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "galoisd",
+		Subsystem: "prover",
+		Name:      "oversized_requests_total",
+		Help:      "Number of requests rejected before decoding for exceeding what the circuit can take, by message.",
+	}, []string{"message"})
+)
+
+// Largest ProveRequest a client can legitimately send: every field at its
+// maximal size, each commit holding as many validators and signatures as the
+// circuit handles.
+func maxProveRequestSize() int {
+	hash := make([]byte, 32)
+	chainID := strings.Repeat("x", types.MaxChainIDLen)
+	farFuture := time.Unix(math.MaxInt32, 999999999)
+	commit := func() *grpc.ValidatorSetCommit {
+		c := &grpc.ValidatorSetCommit{Bitmap: make([]byte, (lightclient.MaxVal+7)/8)}
+		for i := 0; i < lightclient.MaxVal; i++ {
+			c.Validators = append(c.Validators, &tmtypes.SimpleValidator{
+				PubKey: &cryptoproto.PublicKey{
+					Sum: &cryptoproto.PublicKey_Bn254{Bn254: make([]byte, cometbn254.PubKeySize)},
+				},
+				VotingPower: math.MinInt64,
+			})
+			c.Signatures = append(c.Signatures, make([]byte, curve.SizeOfG2AffineCompressed))
+		}
+		return c
+	}
+	req := &grpc.ProveRequest{
+		Vote: &tmtypes.CanonicalVote{
+			Type:   math.MaxInt32,
+			Height: math.MaxInt64,
+			Round:  math.MaxInt64,
+			BlockID: &tmtypes.CanonicalBlockID{
+				Hash:          hash,
+				PartSetHeader: tmtypes.CanonicalPartSetHeader{Total: math.MaxUint32, Hash: hash},
+			},
+			ChainID: chainID,
+		},
+		UntrustedHeader: &tmtypes.Header{
+			Version: version.Consensus{Block: math.MaxUint64, App: math.MaxUint64},
+			ChainID: chainID,
+			Height:  math.MinInt64,
+			Time:    farFuture,
+			LastBlockId: tmtypes.BlockID{
+				Hash:          hash,
+				PartSetHeader: tmtypes.PartSetHeader{Total: math.MaxUint32, Hash: hash},
+			},
+			LastCommitHash:     hash,
+			DataHash:           hash,
+			ValidatorsHash:     hash,
+			NextValidatorsHash: hash,
+			ConsensusHash:      hash,
+			AppHash:            hash,
+			LastResultsHash:    hash,
+			EvidenceHash:       hash,
+			ProposerAddress:    hash,
+		},
+		TrustedCommit:          commit(),
+		UntrustedCommit:        commit(),
+		CircuitId:              hash,
+		InputsCommitmentScheme: math.MaxInt32,
+	}
+	return proto.Size(req)
+}
+
+var maxRequestSize = sync.OnceValue(func() int {
+	// Leaves room for the envelope of the message carrying the request and
+	// fields encoded sub-optimally by the clients.
+	return 2*maxProveRequestSize() + 4096
+})
+
+// Default bound of the requests carrying a commit, derived from the circuit.
+func MaxRequestSize() int {
+	return maxRequestSize()
+}
+
+// Number of validators and signatures of an encoded commit.
+func countCommit(b []byte) (int, int, bool) {
+	var validators, signatures int
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return 0, 0, false
+		}
+		b = b[n:]
+		switch num {
+		case 1:
+			validators++
+		case 2:
+			signatures++
+		}
+		n = protowire.ConsumeFieldValue(num, typ, b)
+		if n < 0 {
+			return 0, 0, false
+		}
+		b = b[n:]
+	}
+	return validators, signatures, true
+}
+
+// Check the commits of an encoded ProveRequest, or of a message embedding it at
+// path, can be handled by the circuit without decoding them. Malformed messages
+// are left to the decoder.
+func checkEnvelope(b []byte, path ...protowire.Number) error {
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return nil
+		}
+		b = b[n:]
+		if typ != protowire.BytesType {
+			n = protowire.ConsumeFieldValue(num, typ, b)
+			if n < 0 {
+				return nil
+			}
+			b = b[n:]
+			continue
+		}
+		value, n := protowire.ConsumeBytes(b)
+		if n < 0 {
+			return nil
+		}
+		b = b[n:]
+		if len(path) > 0 {
+			if num == path[0] {
+				if err := checkEnvelope(value, path[1:]...); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		// Trusted and untrusted commits
+		if num == 3 || num == 4 {
+			validators, signatures, ok := countCommit(value)
+			if ok && (validators > lightclient.MaxVal || signatures > lightclient.MaxVal) {
+				return apierror.New(apierror.ErrTooLarge, "Commit of %d validators and %d signatures, the circuit can handle a maximum of %d validators", validators, signatures, lightclient.MaxVal)
+			}
+		}
+	}
+	return nil
+}
+
+// Where the ProveRequest is embedded in the messages carrying one, each path
+// being checked.
+var envelopes = map[protoreflect.FullName][][]protowire.Number{
+	"union.galois.api.v3.ProveRequest":        {{}},
+	"union.galois.api.v3.PollRequest":         {{1}, {2, 1}},
+	"union.galois.api.v3.UploadInputsRequest": {{1}},
+	"union.galois.api.v3.DiffInputsRequest":   {{1}},
+	"union.galois.api.v3.ProveStreamChunk":    nil,
+	// Pending and finished requests of the snapshot entries
+	"union.galois.api.v3.RestoreSnapshotRequest": {{1, 1, 2}, {1, 1, 5}},
+}
+
+// Envelopes embedding any number of requests, only their commits are bounded.
+var unboundedEnvelopes = map[protoreflect.FullName]bool{
+	"union.galois.api.v3.RestoreSnapshotRequest": true,
+}
+
+// Codec accounting for the size of the requests carrying a commit, rejecting
+// those exceeding what the circuit can take before decoding them. Other messages
+// are decoded as is.
+//
+// gRPC turns the errors of the codec into INTERNAL statuses, hence a rejected
+// message is left empty and its error recorded, to be returned by the
+// interceptors of the codec.
+type sizeCodec struct {
+	encoding.CodecV2
+	maxSize int
+	// Error of the rejected messages, by message
+	rejected sync.Map
+}
+
+func newSizeCodec(maxSize int) *sizeCodec {
+	return &sizeCodec{
+		CodecV2: encoding.GetCodecV2(protocodec.Name),
+		maxSize: maxSize,
+	}
+}
+
+func (c *sizeCodec) Unmarshal(data mem.BufferSlice, v any) error {
+	message, ok := v.(proto.Message)
+	if !ok {
+		return c.CodecV2.Unmarshal(data, v)
+	}
+	descriptor := message.ProtoReflect().Descriptor()
+	paths, found := envelopes[descriptor.FullName()]
+	if !found {
+		return c.CodecV2.Unmarshal(data, v)
+	}
+	name := string(descriptor.Name())
+	size := data.Len()
+	requestBytesHistogram.WithLabelValues(name).Observe(float64(size))
+	err := func() error {
+		if size > c.maxSize && !unboundedEnvelopes[descriptor.FullName()] {
+			return apierror.New(apierror.ErrTooLarge, "%s of %d bytes, larger than the %d bytes a request for %d validators takes at most", name, size, c.maxSize, lightclient.MaxVal)
+		}
+		if len(paths) == 0 {
+			return nil
+		}
+		buf := data.MaterializeToBuffer(mem.DefaultBufferPool())
+		defer buf.Free()
+		for _, path := range paths {
+			if err := checkEnvelope(buf.ReadOnlyData(), path...); err != nil {
+				return err
+			}
+		}
+		return nil
+	}()
+	if err != nil {
+		oversizedRequestsCounter.WithLabelValues(name).Inc()
+		log.Warn().Str("message", name).Int("bytes", size).Err(err).Msg("Rejected oversized request")
+		c.rejected.Store(message, err)
+		return nil
+	}
+	return c.CodecV2.Unmarshal(data, v)
+}
+
+// Error of the message if the codec rejected it.
+func (c *sizeCodec) rejection(m any) error {
+	if m == nil {
+		return nil
+	}
+	if err, found := c.rejected.LoadAndDelete(m); found {
+		return err.(error)
+	}
+	return nil
+}
+
+// Returns the rejections of the unary requests, must be the first interceptor.
+func (c *sizeCodec) UnaryServerInterceptor() ggrpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *ggrpc.UnaryServerInfo, handler ggrpc.UnaryHandler) (any, error) {
+		if err := c.rejection(req); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+type sizeCheckedStream struct {
+	ggrpc.ServerStream
+	codec *sizeCodec
+}
+
+func (s *sizeCheckedStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return s.codec.rejection(m)
+}
+
+// Returns the rejections of the streamed requests, must be the first interceptor.
+func (c *sizeCodec) StreamServerInterceptor() ggrpc.StreamServerInterceptor {
+	return func(srv any, ss ggrpc.ServerStream, info *ggrpc.StreamServerInfo, handler ggrpc.StreamHandler) error {
+		return handler(srv, &sizeCheckedStream{ServerStream: ss, codec: c})
+	}
+}
@@ -0,0 +1,115 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	grpc "galois/grpc/api/v3"
+	"galois/pkg/apierror"
+	"galois/pkg/lightclient"
+	"io"
+	"net"
+	"testing"
+
+	tmtypes "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	ggrpc "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+type sizeTestServer struct {
+	grpc.UnimplementedUnionProverAPIServer
+}
+
+func (s *sizeTestServer) Prove(ctx context.Context, req *grpc.ProveRequest) (*grpc.ProveResponse, error) {
+	return &grpc.ProveResponse{}, nil
+}
+
+func (s *sizeTestServer) ProveStream(stream grpc.UnionProverAPI_ProveStreamServer) error {
+	for {
+		_, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return stream.SendAndClose(&grpc.ProveStreamResponse{})
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// A client of a server bounding the requests to maxSize, set up as Serve does.
+func sizeTestClient(t *testing.T, maxSize int) grpc.UnionProverAPIClient {
+	codec := newSizeCodec(maxSize)
+	server := ggrpc.NewServer(
+		ggrpc.ChainUnaryInterceptor(codec.UnaryServerInterceptor()),
+		ggrpc.ChainStreamInterceptor(codec.StreamServerInterceptor()),
+		ggrpc.ForceServerCodecV2(codec),
+	)
+	grpc.RegisterUnionProverAPIServer(server, &sizeTestServer{})
+	listener := bufconn.Listen(1 << 20)
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	conn, err := ggrpc.NewClient("passthrough:///bufnet",
+		ggrpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		ggrpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return grpc.NewUnionProverAPIClient(conn)
+}
+
+func assertTooLarge(t *testing.T, err error) {
+	t.Helper()
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.ErrorIs(t, apierror.FromError(err), apierror.ErrTooLarge)
+}
+
+func TestOversizedRequestIsRejected(t *testing.T) {
+	client := sizeTestClient(t, 128)
+
+	_, err := client.Prove(context.Background(), &grpc.ProveRequest{CircuitId: make([]byte, 32)})
+	assert.NoError(t, err)
+
+	_, err = client.Prove(context.Background(), &grpc.ProveRequest{CircuitId: make([]byte, 256)})
+	assertTooLarge(t, err)
+
+	// The connection remains usable
+	_, err = client.Prove(context.Background(), &grpc.ProveRequest{CircuitId: make([]byte, 32)})
+	assert.NoError(t, err)
+}
+
+func TestCommitExceedingTheCircuitIsRejected(t *testing.T) {
+	client := sizeTestClient(t, MaxRequestSize())
+
+	commit := &grpc.ValidatorSetCommit{}
+	for i := 0; i <= lightclient.MaxVal; i++ {
+		commit.Validators = append(commit.Validators, &tmtypes.SimpleValidator{})
+	}
+	_, err := client.Prove(context.Background(), &grpc.ProveRequest{TrustedCommit: commit})
+	assertTooLarge(t, err)
+
+	_, err = client.Poll(context.Background(), &grpc.PollRequest{Request: &grpc.ProveRequest{UntrustedCommit: commit}})
+	assertTooLarge(t, err)
+}
+
+func TestOversizedChunkIsRejected(t *testing.T) {
+	client := sizeTestClient(t, 128)
+
+	stream, err := client.ProveStream(context.Background())
+	require.NoError(t, err)
+	chunk := &grpc.ProveStreamChunk{
+		Chunk: &grpc.ProveStreamChunk_Header{Header: &grpc.ProveStreamHeader{CircuitId: make([]byte, 256)}},
+	}
+	// The rejection may be observed by either call
+	err = stream.Send(chunk)
+	if err == nil {
+		_, err = stream.CloseAndRecv()
+	}
+	assertTooLarge(t, err)
+}
@@ -0,0 +1,204 @@
+package grpc
+
+import (
+	"bytes"
+	context "context"
+	"errors"
+	"fmt"
+	grpc "galois/grpc/api/v3"
+	"galois/pkg/apierror"
+	"galois/pkg/storage"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/proto"
+)
+
+func (*proverServer) mustEmbedUnimplementedUnionProverAdminAPIServer() {}
+
+// Capture the pending, completed and failed jobs of the server.
+func (p *proverServer) Snapshot() *grpc.Snapshot {
+	snapshot := &grpc.Snapshot{}
+	p.results.Range(func(key, value any) bool {
+		proveKey := key.([32]byte)
+		entry := &grpc.SnapshotEntry{
+			RequestHash: proveKey[:],
+		}
+		switch result := value.(type) {
+		case *grpc.ProveRequestPending:
+			req, found := p.requests.Load(proveKey)
+			if !found {
+				// The job is being admitted, the client will poll it again
+				return true
+			}
+			entry.State = &grpc.SnapshotEntry_Pending{
+				Pending: req.(*grpc.ProveRequest),
+			}
+		case *grpc.ProveResponse:
+			entry.State = &grpc.SnapshotEntry_Done{
+				Done: result,
+			}
+		case error:
+			entry.State = &grpc.SnapshotEntry_Failed{
+				Failed: result.Error(),
+			}
+		}
+		_, pending := entry.State.(*grpc.SnapshotEntry_Pending)
+		p.updateJob(proveKey, func(j *job) {
+			entry.Tenant = j.tenant
+			if !pending {
+				entry.Request = j.request
+			}
+		})
+		if !pending && entry.Request == nil {
+			// Finished jobs are restored only along with their request
+			return true
+		}
+		snapshot.Entries = append(snapshot.Entries, entry)
+		return true
+	})
+	return snapshot
+}
+
+// Validate a request of a snapshot and compute its key in the namespace of its
+// tenant, the request hash of the snapshot being ignored.
+func (p *proverServer) restoredRequest(tenant string, req *grpc.ProveRequest) ([32]byte, []byte, error) {
+	if req == nil {
+		return [32]byte{}, nil, apierror.New(apierror.ErrInvalidRequest, "Missing request")
+	}
+	if err := p.validateRequest(req); err != nil {
+		return [32]byte{}, nil, err
+	}
+	requestHash, reqJson, err := requestKey(req)
+	if err != nil {
+		return [32]byte{}, nil, err
+	}
+	return tenantKey(tenant, requestHash), reqJson, nil
+}
+
+// Verify a restored proof against the verifying key and the inputs of its
+// request, returning the inputs hash.
+func (p *proverServer) verifyRestoredProof(req *grpc.ProveRequest, res *grpc.ProveResponse) ([]byte, error) {
+	_, trustedValidatorsRoot, err := MarshalValidators(req.TrustedCommit.Validators)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(trustedValidatorsRoot, res.TrustedValidatorSetRoot) {
+		return nil, fmt.Errorf("Trusted validator set root %X, expected %X", res.TrustedValidatorSetRoot, trustedValidatorsRoot)
+	}
+	inputsHash := InputsHash(req.Vote.ChainID, req.UntrustedHeader, trustedValidatorsRoot)
+	if err := p.verifyProof(req.CircuitId, res.Proof, inputsHash); err != nil {
+		return nil, err
+	}
+	return inputsHash, nil
+}
+
+// Load a snapshot into the server. The entries are validated as the requests
+// submitted by the clients are, the completed jobs are restored once their
+// proofs are verified while pending jobs are resumed as long as there are free
+// proving slots or room in the queue.
+func (p *proverServer) Restore(snapshot *grpc.Snapshot) (uint32, uint32) {
+	var restored, resumed uint32
+	for _, entry := range snapshot.Entries {
+		switch state := entry.State.(type) {
+		case *grpc.SnapshotEntry_Pending:
+			proveKey, reqJson, err := p.restoredRequest(entry.Tenant, state.Pending)
+			if err == nil {
+				err = p.checkFreshness(snapshotOwner, state.Pending)
+			}
+			if err != nil {
+				log.Warn().Hex("request_hash", entry.RequestHash).Err(err).Msg("Dropping invalid pending job of the snapshot")
+				continue
+			}
+			if _, found := p.results.LoadOrStore(proveKey, &grpc.ProveRequestPending{}); found {
+				continue
+			}
+			// The jobs of a tenant were admitted before the snapshot, they
+			// may exceed a quota lowered since
+			p.tenants.acquire(entry.Tenant, true)
+			if entry.Tenant != "" {
+				p.trackJob(proveKey, entry.Tenant, true, entry.Tenant, state.Pending)
+			} else {
+				p.trackJob(proveKey, snapshotOwner, false, "", state.Pending)
+			}
+			if p.acquireJob(proveKey, state.Pending.CircuitId) {
+				p.spawn(proveKey, state.Pending, reqJson)
+			} else if !p.enqueue(snapshotOwner, proveKey, state.Pending, reqJson) {
+				log.Warn().Hex("request_hash", proveKey[:]).Msg("No proving slot left, dropping pending job")
+				p.jobs.Delete(proveKey)
+				p.results.Delete(proveKey)
+				p.tenants.release(entry.Tenant)
+				continue
+			}
+			resumed++
+		case *grpc.SnapshotEntry_Done:
+			proveKey, _, err := p.restoredRequest(entry.Tenant, entry.Request)
+			var inputsHash []byte
+			if err == nil {
+				inputsHash, err = p.verifyRestoredProof(entry.Request, state.Done)
+			}
+			if err != nil {
+				log.Warn().Hex("request_hash", entry.RequestHash).Err(err).Msg("Dropping invalid proof of the snapshot")
+				continue
+			}
+			if _, found := p.results.LoadOrStore(proveKey, state.Done); !found {
+				p.trackRestoredJob(proveKey, entry.Tenant, entry.Request, inputsHash)
+				restored++
+			}
+		case *grpc.SnapshotEntry_Failed:
+			proveKey, _, err := p.restoredRequest(entry.Tenant, entry.Request)
+			if err != nil {
+				log.Warn().Hex("request_hash", entry.RequestHash).Err(err).Msg("Dropping invalid failed job of the snapshot")
+				continue
+			}
+			if _, found := p.results.LoadOrStore(proveKey, errors.New(state.Failed)); !found {
+				p.trackRestoredJob(proveKey, entry.Tenant, entry.Request, nil)
+				restored++
+			}
+		}
+	}
+	log.Info().Uint32("restored", restored).Uint32("resumed", resumed).Msg("Snapshot restored")
+	return restored, resumed
+}
+
+func (p *proverServer) SaveSnapshot(ctx context.Context, req *grpc.SaveSnapshotRequest) (*grpc.SaveSnapshotResponse, error) {
+	log.Debug().Msg("Saving snapshot...")
+
+	return &grpc.SaveSnapshotResponse{
+		Snapshot: p.Snapshot(),
+	}, nil
+}
+
+func (p *proverServer) RestoreSnapshot(ctx context.Context, req *grpc.RestoreSnapshotRequest) (*grpc.RestoreSnapshotResponse, error) {
+	log.Debug().Msg("Restoring snapshot...")
+
+	if req.Snapshot == nil {
+		return nil, apierror.New(apierror.ErrInvalidRequest, "Missing snapshot")
+	}
+
+	restored, resumed := p.Restore(req.Snapshot)
+
+	return &grpc.RestoreSnapshotResponse{
+		Restored: restored,
+		Resumed:  resumed,
+	}, nil
+}
+
+func WriteSnapshot(ctx context.Context, store storage.Backend, file string, snapshot *grpc.Snapshot) error {
+	bz, err := proto.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+	return storage.WriteAll(ctx, store, file, bz)
+}
+
+func ReadSnapshot(ctx context.Context, store storage.Backend, file string) (*grpc.Snapshot, error) {
+	bz, err := storage.ReadAll(ctx, store, file)
+	if err != nil {
+		return nil, err
+	}
+	var snapshot grpc.Snapshot
+	if err := proto.Unmarshal(bz, &snapshot); err != nil {
+		return nil, fmt.Errorf("Could not decode snapshot %s", err)
+	}
+	return &snapshot, nil
+}
@@ -0,0 +1,109 @@
+package grpc
+
+import (
+	"errors"
+	grpc "galois/grpc/api/v3"
+	"galois/pkg/apierror"
+	"galois/pkg/lightclient"
+	"io"
+
+	ce "github.com/cometbft/cometbft/crypto/encoding"
+	"github.com/rs/zerolog/log"
+)
+
+// Append a chunk of validators and signatures to a commit, checking it as it
+// arrives such that the stream is rejected before exceeding the circuit.
+func appendChunk(commit *grpc.ValidatorSetCommit, chunk *grpc.ValidatorsChunk) error {
+	if len(commit.Validators)+len(chunk.Validators) > lightclient.MaxVal {
+		return apierror.New(apierror.ErrTooLarge, "The circuit can handle a maximum of %d validators", lightclient.MaxVal)
+	}
+	// The signatures are sent along with or after their validators
+	if len(commit.Signatures)+len(chunk.Signatures) > len(commit.Validators)+len(chunk.Validators) {
+		return apierror.New(apierror.ErrInvalidRequest, "More signatures than validators")
+	}
+	for _, val := range chunk.Validators {
+		if val.PubKey == nil {
+			return apierror.New(apierror.ErrInvalidRequest, "Missing validator public key")
+		}
+		if _, err := ce.PubKeyFromProto(*val.PubKey); err != nil {
+			return apierror.New(apierror.ErrInvalidRequest, "Could not deserialize proto to tendermint public key %s", err)
+		}
+	}
+	commit.Validators = append(commit.Validators, chunk.Validators...)
+	commit.Signatures = append(commit.Signatures, chunk.Signatures...)
+	return nil
+}
+
+// Same as Poll, the request being streamed: a header followed by chunks of the
+// trusted and untrusted validators and signatures, in order. The request is
+// never held beyond the validators the circuit can handle. It is stored as
+// uploaded inputs, the returned handle being polled with ProveFromHandle.
+func (p *proverServer) ProveStream(stream grpc.UnionProverAPI_ProveStreamServer) error {
+	log.Debug().Msg("Receiving streamed request...")
+
+	var req *grpc.ProveRequest
+	for {
+		chunk, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		switch c := chunk.Chunk.(type) {
+		case *grpc.ProveStreamChunk_Header:
+			if req != nil {
+				return apierror.New(apierror.ErrInvalidRequest, "The header must be sent once")
+			}
+			if c.Header.Vote == nil || c.Header.UntrustedHeader == nil {
+				return apierror.New(apierror.ErrInvalidRequest, "Missing vote or untrusted header")
+			}
+			req = &grpc.ProveRequest{
+				Vote:            c.Header.Vote,
+				UntrustedHeader: c.Header.UntrustedHeader,
+				TrustedCommit: &grpc.ValidatorSetCommit{
+					Bitmap: c.Header.TrustedBitmap,
+				},
+				UntrustedCommit: &grpc.ValidatorSetCommit{
+					Bitmap: c.Header.UntrustedBitmap,
+				},
+				CircuitId:              c.Header.CircuitId,
+				InputsCommitmentScheme: c.Header.InputsCommitmentScheme,
+			}
+		case *grpc.ProveStreamChunk_Trusted:
+			if req == nil {
+				return apierror.New(apierror.ErrInvalidRequest, "The header must be sent first")
+			}
+			if err := appendChunk(req.TrustedCommit, c.Trusted); err != nil {
+				return err
+			}
+		case *grpc.ProveStreamChunk_Untrusted:
+			if req == nil {
+				return apierror.New(apierror.ErrInvalidRequest, "The header must be sent first")
+			}
+			if err := appendChunk(req.UntrustedCommit, c.Untrusted); err != nil {
+				return err
+			}
+		default:
+			return apierror.New(apierror.ErrInvalidRequest, "Unknown chunk")
+		}
+	}
+	if req == nil {
+		return apierror.New(apierror.ErrInvalidRequest, "Missing header")
+	}
+
+	handle, err := p.storeInputs(stream.Context(), req)
+	if err != nil {
+		return err
+	}
+	res, err := p.Poll(stream.Context(), &grpc.PollRequest{
+		Request: req,
+	})
+	if err != nil {
+		return err
+	}
+	return stream.SendAndClose(&grpc.ProveStreamResponse{
+		Handle: handle,
+		Result: res,
+	})
+}
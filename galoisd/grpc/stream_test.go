@@ -0,0 +1,47 @@
+package grpc
+
+import (
+	"errors"
+	grpc "galois/grpc/api/v3"
+	"galois/pkg/apierror"
+	"galois/pkg/lightclient"
+	"testing"
+
+	tmtypes "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	ce "github.com/cometbft/cometbft/crypto/encoding"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func chunkOf(t *testing.T, validators int, signatures int) *grpc.ValidatorsChunk {
+	pubKey, err := ce.PubKeyToProto(ed25519.GenPrivKey().PubKey())
+	require.NoError(t, err)
+	chunk := &grpc.ValidatorsChunk{}
+	for i := 0; i < validators; i++ {
+		chunk.Validators = append(chunk.Validators, &tmtypes.SimpleValidator{PubKey: &pubKey, VotingPower: 1})
+	}
+	for i := 0; i < signatures; i++ {
+		chunk.Signatures = append(chunk.Signatures, []byte{byte(i)})
+	}
+	return chunk
+}
+
+func TestAppendChunk(t *testing.T) {
+	commit := &grpc.ValidatorSetCommit{}
+	assert.NoError(t, appendChunk(commit, chunkOf(t, 2, 1)))
+	// The signatures may follow their validators
+	assert.NoError(t, appendChunk(commit, chunkOf(t, 0, 1)))
+	assert.Len(t, commit.Validators, 2)
+	assert.Len(t, commit.Signatures, 2)
+
+	err := appendChunk(commit, chunkOf(t, 0, 1))
+	assert.True(t, errors.Is(err, apierror.ErrInvalidRequest), err)
+	err = appendChunk(commit, chunkOf(t, lightclient.MaxVal-1, 0))
+	assert.True(t, errors.Is(err, apierror.ErrTooLarge), err)
+	err = appendChunk(commit, &grpc.ValidatorsChunk{Validators: []*tmtypes.SimpleValidator{{}}})
+	assert.True(t, errors.Is(err, apierror.ErrInvalidRequest), err)
+	// The rejected chunks are not appended
+	assert.Len(t, commit.Validators, 2)
+	assert.Len(t, commit.Signatures, 2)
+}
@@ -0,0 +1,121 @@
+package grpc
+
+import (
+	context "context"
+	"crypto/sha256"
+	"encoding/binary"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	tenantJobsGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "galoisd",
+		Subsystem: "prover",
+		Name:      "tenant_jobs",
+		Help:      "Number of running and queued jobs of each authenticated tenant.",
+	}, []string{"tenant"})
+	tenantRejectionsCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "galoisd",
+		Subsystem: "prover",
+		Name:      "tenant_quota_rejections_total",
+		Help:      "Number of jobs rejected for exceeding the quota of their tenant.",
+	}, []string{"tenant"})
+)
+
+// Tenants are the authenticated identities of the callers, either attached by
+// an authentication interceptor or the principals of the authorization policy.
+// Each tenant has its own namespace of jobs, such that a tenant can neither
+// observe nor cancel the jobs of another one, and its own quota. The
+// unauthenticated callers share the default namespace, without quota.
+func tenantFromContext(ctx context.Context) string {
+	id, authenticated := callerFromContext(ctx)
+	if !authenticated {
+		return ""
+	}
+	return id
+}
+
+// Key of a request in the namespace of a tenant. Requests of the default
+// namespace are keyed by their hash, as computed by the clients.
+func tenantKey(tenant string, requestHash [32]byte) [32]byte {
+	if tenant == "" {
+		return requestHash
+	}
+	h := sha256.New()
+	h.Write([]byte("galoisd/tenant"))
+	var length [8]byte
+	binary.BigEndian.PutUint64(length[:], uint64(len(tenant)))
+	h.Write(length[:])
+	h.Write([]byte(tenant))
+	h.Write(requestHash[:])
+	var key [32]byte
+	h.Sum(key[:0])
+	return key
+}
+
+// Running and queued jobs of the tenants.
+type tenantQuotas struct {
+	mu sync.Mutex
+	// Maximum number of jobs of a tenant, unlimited if zero
+	maxJobs int
+	jobs    map[string]int
+}
+
+// WithTenantMaxJobs bounds the number of running and queued jobs of each
+// authenticated tenant, such that one of them can't exhaust the proving slots
+// and the queue. Zero means unlimited.
+func WithTenantMaxJobs(maxJobs int) Option {
+	return func(p *proverServer) {
+		p.tenants.maxJobs = maxJobs
+	}
+}
+
+// Account a new job of the tenant, returning false if it exceeds its quota
+// unless forced.
+func (q *tenantQuotas) acquire(tenant string, force bool) bool {
+	if tenant == "" {
+		return true
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if !force && q.maxJobs > 0 && q.jobs[tenant] >= q.maxJobs {
+		tenantRejectionsCounter.WithLabelValues(tenant).Inc()
+		return false
+	}
+	if q.jobs == nil {
+		q.jobs = make(map[string]int)
+	}
+	q.jobs[tenant]++
+	tenantJobsGauge.WithLabelValues(tenant).Set(float64(q.jobs[tenant]))
+	return true
+}
+
+func (q *tenantQuotas) release(tenant string) {
+	if tenant == "" {
+		return
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.jobs[tenant] <= 1 {
+		delete(q.jobs, tenant)
+		tenantJobsGauge.DeleteLabelValues(tenant)
+		return
+	}
+	q.jobs[tenant]--
+	tenantJobsGauge.WithLabelValues(tenant).Set(float64(q.jobs[tenant]))
+}
+
+// Release the quota held by a job once it is no longer running nor queued.
+func (p *proverServer) releaseTenantJob(proveKey [32]byte) {
+	var tenant string
+	p.updateJob(proveKey, func(j *job) {
+		if j.tenant != "" && !j.quotaReleased {
+			j.quotaReleased = true
+			tenant = j.tenant
+		}
+	})
+	p.tenants.release(tenant)
+}
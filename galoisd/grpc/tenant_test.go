@@ -0,0 +1,68 @@
+package grpc
+
+import (
+	context "context"
+	"crypto/sha256"
+	"errors"
+	grpc "galois/grpc/api/v3"
+	"galois/pkg/apierror"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTenantKey(t *testing.T) {
+	requestHash := sha256.Sum256([]byte("request"))
+
+	// The default namespace keeps the hashes computed by the clients
+	assert.Equal(t, requestHash, tenantKey("", requestHash))
+
+	a := tenantKey("relayer-a", requestHash)
+	assert.NotEqual(t, requestHash, a)
+	assert.NotEqual(t, a, tenantKey("relayer-b", requestHash))
+	assert.Equal(t, a, tenantKey("relayer-a", requestHash))
+}
+
+func TestTenantQuotas(t *testing.T) {
+	q := tenantQuotas{maxJobs: 2}
+	assert.True(t, q.acquire("relayer-a", false))
+	assert.True(t, q.acquire("relayer-a", false))
+	assert.False(t, q.acquire("relayer-a", false))
+	// Quotas are independent
+	assert.True(t, q.acquire("relayer-b", false))
+	// Unauthenticated clients have no quota
+	assert.True(t, q.acquire("", false))
+	assert.True(t, q.acquire("", false))
+	assert.True(t, q.acquire("", false))
+
+	q.release("relayer-a")
+	assert.True(t, q.acquire("relayer-a", false))
+	// Restored jobs are accounted whatever the quota
+	assert.True(t, q.acquire("relayer-a", true))
+	assert.Equal(t, 3, q.jobs["relayer-a"])
+}
+
+func TestCancelJobOfAnotherTenant(t *testing.T) {
+	p := &proverServer{}
+	requestHash := sha256.Sum256([]byte("request"))
+	proveKey := tenantKey("relayer-a", requestHash)
+	p.results.Store(proveKey, &grpc.ProveRequestPending{})
+	p.trackJob(proveKey, "relayer-a", true, "relayer-a", &grpc.ProveRequest{})
+
+	req := &grpc.CancelJobRequest{RequestHash: requestHash[:]}
+	for _, ctx := range []context.Context{
+		context.Background(),
+		ContextWithClientID(context.Background(), "relayer-b"),
+	} {
+		_, err := p.CancelJob(ctx, req)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, apierror.ErrUnknownJob), err)
+	}
+
+	// The job is found in the namespace of its tenant, and being neither
+	// queued nor running yet, is still being admitted
+	_, err := p.CancelJob(ContextWithClientID(context.Background(), "relayer-a"), req)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, apierror.ErrJobAdmitting), err)
+}
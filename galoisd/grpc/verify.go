@@ -0,0 +1,22 @@
+package grpc
+
+import (
+	"bytes"
+	"fmt"
+	"galois/pkg/artifacts"
+	"galois/pkg/verify"
+	"os"
+)
+
+// Load the verifying key from vkPath, falling back to the key embedded in the
+// binary if the file doesn't exist.
+func LoadVerifyingKey(vkPath string) (*verify.VerifyingKey, error) {
+	bz, err := os.ReadFile(vkPath)
+	if os.IsNotExist(err) && artifacts.VerifyingKey() != nil {
+		bz, err = artifacts.VerifyingKey(), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Could not read verifying key %s", err)
+	}
+	return verify.ReadVerifyingKey(bytes.NewReader(bz))
+}
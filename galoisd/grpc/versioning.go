@@ -0,0 +1,37 @@
+package grpc
+
+import (
+	"fmt"
+	grpc "galois/grpc/api/v3"
+	"galois/pkg/apierror"
+)
+
+// Each schema of the prove request has an adapter turning it into the request
+// the circuit is proven against. Introducing a schema means adding a message
+// to the VersionedProveRequest oneof and its adapter below, instead of
+// renumbering the fields of ProveRequest and breaking the deployed relayers.
+// The request hash is computed after adaptation, the same request gives the
+// same job whatever the schema it was submitted with.
+func adaptProveRequest(req *grpc.VersionedProveRequest) (*grpc.ProveRequest, error) {
+	switch schema := req.Schema.(type) {
+	case *grpc.VersionedProveRequest_V3:
+		return schema.V3, nil
+	default:
+		// Sent by a client more recent than the prover
+		return nil, fmt.Errorf("Unsupported prove request schema, the prover must be upgraded")
+	}
+}
+
+// The request of a poll, either submitted as is or with an explicit schema.
+func pollRequest(pollReq *grpc.PollRequest) (*grpc.ProveRequest, error) {
+	if pollReq.VersionedRequest != nil {
+		if pollReq.Request != nil {
+			return nil, apierror.New(apierror.ErrInvalidRequest, "Only one of request and versioned_request can be set")
+		}
+		return adaptProveRequest(pollReq.VersionedRequest)
+	}
+	if pollReq.Request == nil {
+		return nil, apierror.New(apierror.ErrInvalidRequest, "Missing request")
+	}
+	return pollReq.Request, nil
+}
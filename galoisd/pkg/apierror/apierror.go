@@ -0,0 +1,154 @@
+// Package apierror defines the errors of the prover API, such that clients can
+// handle them with errors.Is and errors.As instead of matching messages. The
+// server returns them as gRPC statuses carrying an ErrorInfo with their reason,
+// decoded back by FromError or the client interceptors.
+package apierror
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	grpc "galois/grpc/api/v3"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	ggrpc "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// Domain of the ErrorInfo attached to the statuses.
+const Domain = "galois.union.build"
+
+type Error struct {
+	Code    codes.Code
+	Reason  string
+	Message string
+	// Other details of the status, e.g. when to retry
+	details []protoadapt.MessageV1
+	// The status the error was decoded from, if any
+	status *status.Status
+}
+
+var (
+	ErrInvalidRequest  = register(codes.InvalidArgument, "INVALID_REQUEST", "invalid request")
+	ErrCircuitMismatch = register(codes.FailedPrecondition, "CIRCUIT_MISMATCH", "circuit not served")
+	ErrUnsatisfied     = register(codes.InvalidArgument, "UNSATISFIED", "the inputs don't satisfy the circuit")
+	ErrOverloaded      = register(codes.ResourceExhausted, "OVERLOADED", "busy_building")
+	ErrDraining        = register(codes.Unavailable, "DRAINING", "draining")
+	ErrUnknownJob      = register(codes.NotFound, "UNKNOWN_JOB", "unknown job")
+	ErrUnknownHandle   = register(codes.NotFound, "UNKNOWN_HANDLE", "unknown handle")
+	ErrCancelled       = register(codes.Canceled, "CANCELLED", "job cancelled")
+	ErrStale           = register(codes.FailedPrecondition, "STALE", "stale request")
+	ErrTooLarge        = register(codes.InvalidArgument, "TOO_LARGE", "request too large")
+	ErrNotOwner        = register(codes.PermissionDenied, "NOT_OWNER", "not the owner of the job")
+	ErrJobCompleted    = register(codes.FailedPrecondition, "JOB_COMPLETED", "job already completed")
+	ErrJobAdmitting    = register(codes.Unavailable, "JOB_ADMITTING", "job being admitted")
+	ErrQuotaExceeded   = register(codes.ResourceExhausted, "QUOTA_EXCEEDED", "quota exceeded")
+	ErrKeysReloading   = register(codes.Aborted, "KEYS_RELOADING", "keys being reloaded")
+)
+
+var reasons = map[string]*Error{}
+
+func register(code codes.Code, reason string, message string) *Error {
+	err := &Error{Code: code, Reason: reason, Message: message}
+	reasons[reason] = err
+	return err
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// Errors are compared by reason, whatever their message.
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	return ok && t.Reason == e.Reason
+}
+
+// New error of the given kind with a specific message.
+func New(kind *Error, format string, args ...any) *Error {
+	return &Error{Code: kind.Code, Reason: kind.Reason, Message: fmt.Sprintf(format, args...)}
+}
+
+// A copy of the error with additional status details.
+func (e *Error) WithDetails(details ...protoadapt.MessageV1) *Error {
+	clone := *e
+	clone.details = append(append([]protoadapt.MessageV1(nil), e.details...), details...)
+	return &clone
+}
+
+// Used by gRPC to convert the errors returned by the handlers.
+func (e *Error) GRPCStatus() *status.Status {
+	if e.status != nil {
+		return e.status
+	}
+	st := status.New(e.Code, e.Message)
+	details := append([]protoadapt.MessageV1{&errdetails.ErrorInfo{Reason: e.Reason, Domain: Domain}}, e.details...)
+	if withDetails, err := st.WithDetails(details...); err == nil {
+		return withDetails
+	}
+	return st
+}
+
+// Reason of the API error wrapped by err, if any.
+func Reason(err error) string {
+	var apiErr *Error
+	if errors.As(err, &apiErr) {
+		return apiErr.Reason
+	}
+	return ""
+}
+
+// Decode the API error returned by a call. Other errors are returned as is.
+func FromError(err error) error {
+	st, ok := status.FromError(err)
+	if !ok || err == nil {
+		return err
+	}
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok && info.Domain == Domain {
+			return &Error{Code: st.Code(), Reason: info.Reason, Message: st.Message(), status: st}
+		}
+	}
+	return err
+}
+
+// Decode the failure of a job.
+func FromFailed(failed *grpc.ProveRequestFailed) error {
+	kind, found := reasons[failed.Reason]
+	if !found {
+		return errors.New(failed.Message)
+	}
+	return New(kind, "%s", failed.Message)
+}
+
+// Decodes the errors of the unary calls.
+func UnaryClientInterceptor() ggrpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *ggrpc.ClientConn, invoker ggrpc.UnaryInvoker, opts ...ggrpc.CallOption) error {
+		return FromError(invoker(ctx, method, req, reply, cc, opts...))
+	}
+}
+
+type decodingStream struct {
+	ggrpc.ClientStream
+}
+
+func (s *decodingStream) SendMsg(m any) error {
+	return FromError(s.ClientStream.SendMsg(m))
+}
+
+func (s *decodingStream) RecvMsg(m any) error {
+	return FromError(s.ClientStream.RecvMsg(m))
+}
+
+// Decodes the errors of the streaming calls.
+func StreamClientInterceptor() ggrpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *ggrpc.StreamDesc, cc *ggrpc.ClientConn, method string, streamer ggrpc.Streamer, opts ...ggrpc.CallOption) (ggrpc.ClientStream, error) {
+		stream, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			return nil, FromError(err)
+		}
+		return &decodingStream{stream}, nil
+	}
+}
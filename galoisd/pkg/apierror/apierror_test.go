@@ -0,0 +1,74 @@
+package apierror
+
+import (
+	"errors"
+	"fmt"
+	grpc "galois/grpc/api/v3"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// What the client receives once the error went through the wire.
+func roundTrip(err error) error {
+	st, _ := status.FromError(err)
+	return status.ErrorProto(st.Proto())
+}
+
+func TestRoundTrip(t *testing.T) {
+	sent := New(ErrCircuitMismatch, "Unknown circuit %x", []byte{1})
+	received := FromError(roundTrip(sent))
+	assert.ErrorIs(t, received, ErrCircuitMismatch)
+	assert.NotErrorIs(t, received, ErrUnknownJob)
+	assert.Equal(t, "Unknown circuit 01", received.Error())
+	assert.Equal(t, codes.FailedPrecondition, status.Code(received))
+
+	var apiErr *Error
+	assert.True(t, errors.As(received, &apiErr))
+	assert.Equal(t, "CIRCUIT_MISMATCH", apiErr.Reason)
+}
+
+func TestDetailsArePreserved(t *testing.T) {
+	sent := ErrOverloaded.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(3 * time.Second)})
+	received := FromError(roundTrip(sent))
+	assert.ErrorIs(t, received, ErrOverloaded)
+	assert.Equal(t, "busy_building", status.Convert(received).Message())
+
+	var retryDelay time.Duration
+	for _, detail := range status.Convert(received).Details() {
+		if info, ok := detail.(*errdetails.RetryInfo); ok {
+			retryDelay = info.RetryDelay.AsDuration()
+		}
+	}
+	assert.Equal(t, 3*time.Second, retryDelay)
+	// The sentinel itself is left untouched
+	assert.Empty(t, ErrOverloaded.details)
+}
+
+func TestOtherErrors(t *testing.T) {
+	assert.NoError(t, FromError(nil))
+	err := status.Error(codes.Internal, "boom")
+	assert.Equal(t, err, FromError(err))
+	plain := errors.New("boom")
+	assert.Equal(t, plain, FromError(plain))
+	assert.Equal(t, "", Reason(plain))
+}
+
+func TestFromFailed(t *testing.T) {
+	failed := &grpc.ProveRequestFailed{
+		Message: "failed to generate proof: constraint #1 is not satisfied",
+		Reason:  Reason(fmt.Errorf("failed to generate proof: %w", New(ErrUnsatisfied, "constraint #1 is not satisfied"))),
+	}
+	err := FromFailed(failed)
+	assert.ErrorIs(t, err, ErrUnsatisfied)
+	assert.Equal(t, failed.Message, err.Error())
+
+	err = FromFailed(&grpc.ProveRequestFailed{Message: "disk full"})
+	assert.NotErrorIs(t, err, ErrUnsatisfied)
+	assert.Equal(t, "disk full", err.Error())
+}
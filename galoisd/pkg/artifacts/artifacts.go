@@ -0,0 +1,30 @@
+// Package artifacts exposes the circuit artifacts embedded in the binary at
+// build time. To embed the verifying key, copy it to pkg/artifacts/vk.bin and
+// build with the embed_vk tag:
+//
+//	cp vk.bin pkg/artifacts/ && go build -tags binary,embed_vk ./cmd/galoisd
+//
+// The embed_dev_circuit tag additionally embeds pkg/artifacts/r1cs.bin and
+// pkg/artifacts/pk.bin, only sensible for the small development circuits.
+package artifacts
+
+var (
+	verifyingKey []byte
+	r1cs         []byte
+	provingKey   []byte
+)
+
+// VerifyingKey returns the embedded verifying key, nil if none was embedded.
+func VerifyingKey() []byte {
+	return verifyingKey
+}
+
+// Circuit returns the embedded R1CS and proving key, nil if none were embedded.
+func Circuit() ([]byte, []byte) {
+	return r1cs, provingKey
+}
+
+// HasCircuit returns whether the full set of proving artifacts is embedded.
+func HasCircuit() bool {
+	return r1cs != nil && provingKey != nil && verifyingKey != nil
+}
@@ -0,0 +1,20 @@
+//go:build embed_dev_circuit
+// +build embed_dev_circuit
+
+package artifacts
+
+import (
+	_ "embed"
+)
+
+var (
+	//go:embed r1cs.bin
+	embeddedR1CS []byte
+	//go:embed pk.bin
+	embeddedProvingKey []byte
+)
+
+func init() {
+	r1cs = embeddedR1CS
+	provingKey = embeddedProvingKey
+}
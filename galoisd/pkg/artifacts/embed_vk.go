@@ -0,0 +1,15 @@
+//go:build embed_vk || embed_dev_circuit
+// +build embed_vk embed_dev_circuit
+
+package artifacts
+
+import (
+	_ "embed"
+)
+
+//go:embed vk.bin
+var embeddedVerifyingKey []byte
+
+func init() {
+	verifyingKey = embeddedVerifyingKey
+}
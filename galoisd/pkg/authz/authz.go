@@ -0,0 +1,247 @@
+// Package authz authenticates the callers of the gRPC services with bearer
+// tokens and authorizes them per method, following a policy file that is
+// reloaded as soon as it changes.
+package authz
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Policy as written in the policy file. Methods are full gRPC method names such
+// as /union.galois.api.v3.UnionProverAPI/Verify, a service followed by /* to
+// match all its methods or * to match any method.
+type Policy struct {
+	Principals map[string]Principal `json:"principals"`
+	// Methods callable without a token, e.g. the health service
+	Anonymous []string `json:"anonymous"`
+}
+
+type Principal struct {
+	// Hex encoded sha256 of the bearer token, the token itself never being
+	// written to the policy file.
+	TokenSHA256 string   `json:"token_sha256"`
+	Methods     []string `json:"methods"`
+}
+
+type principalKey struct{}
+
+// Name of the principal authenticated for the call, if any.
+func PrincipalFromContext(ctx context.Context) (string, bool) {
+	principal, ok := ctx.Value(principalKey{}).(string)
+	return principal, ok
+}
+
+type methods []string
+
+func (m methods) allows(method string) bool {
+	for _, pattern := range m {
+		if pattern == "*" || pattern == method {
+			return true
+		}
+		if service, found := strings.CutSuffix(pattern, "/*"); found && strings.HasPrefix(method, service+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+func validMethods(patterns []string) error {
+	for _, pattern := range patterns {
+		if pattern != "*" && !strings.HasPrefix(pattern, "/") {
+			return fmt.Errorf("Invalid method %q, expected /package.Service/Method, /package.Service/* or *", pattern)
+		}
+	}
+	return nil
+}
+
+type principal struct {
+	name    string
+	methods methods
+}
+
+// The policy ready to be evaluated.
+type compiled struct {
+	principals map[[sha256.Size]byte]principal
+	anonymous  methods
+}
+
+func compile(policy Policy) (*compiled, error) {
+	c := &compiled{
+		principals: make(map[[sha256.Size]byte]principal, len(policy.Principals)),
+		anonymous:  policy.Anonymous,
+	}
+	if err := validMethods(policy.Anonymous); err != nil {
+		return nil, err
+	}
+	for name, p := range policy.Principals {
+		var tokenHash [sha256.Size]byte
+		bz, err := hex.DecodeString(p.TokenSHA256)
+		if err != nil || len(bz) != sha256.Size {
+			return nil, fmt.Errorf("Invalid token_sha256 of principal %s, expected %d hex encoded bytes", name, sha256.Size)
+		}
+		copy(tokenHash[:], bz)
+		if other, found := c.principals[tokenHash]; found {
+			return nil, fmt.Errorf("Principals %s and %s share the same token", other.name, name)
+		}
+		if err := validMethods(p.Methods); err != nil {
+			return nil, fmt.Errorf("Principal %s: %s", name, err)
+		}
+		c.principals[tokenHash] = principal{name: name, methods: p.Methods}
+	}
+	return c, nil
+}
+
+func readPolicy(path string) (*compiled, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("Could not read authorization policy %s", err)
+	}
+	var policy Policy
+	if err := json.Unmarshal(bz, &policy); err != nil {
+		return nil, fmt.Errorf("Could not decode authorization policy %s", err)
+	}
+	return compile(policy)
+}
+
+type Authorizer struct {
+	path string
+
+	mu      sync.RWMutex
+	policy  *compiled
+	modTime time.Time
+}
+
+func New(path string) (*Authorizer, error) {
+	a := &Authorizer{path: path}
+	if _, err := a.Reload(); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// Reload the policy if the file changed, returning whether a new policy has
+// been loaded. The current policy is kept if the new one is invalid.
+func (a *Authorizer) Reload() (bool, error) {
+	info, err := os.Stat(a.path)
+	if err != nil {
+		return false, err
+	}
+	a.mu.RLock()
+	unchanged := a.policy != nil && info.ModTime().Equal(a.modTime)
+	a.mu.RUnlock()
+	if unchanged {
+		return false, nil
+	}
+	policy, err := readPolicy(a.path)
+	if err != nil {
+		return false, err
+	}
+	a.mu.Lock()
+	a.policy = policy
+	a.modTime = info.ModTime()
+	a.mu.Unlock()
+	return true, nil
+}
+
+// Periodically reload the policy until the context is done.
+func (a *Authorizer) Watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			a.ReloadAndLog()
+		}
+	}
+}
+
+func (a *Authorizer) ReloadAndLog() {
+	reloaded, err := a.Reload()
+	if err != nil {
+		log.Warn().Str("policy", a.path).Err(err).Msg("Could not reload authorization policy, keeping the current one")
+	} else if reloaded {
+		log.Info().Str("policy", a.path).Msg("Authorization policy reloaded")
+	}
+}
+
+func bearerToken(ctx context.Context) (string, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", false
+	}
+	for _, value := range md.Get("authorization") {
+		if token, found := strings.CutPrefix(value, "Bearer "); found {
+			return token, true
+		}
+	}
+	return "", false
+}
+
+// Authenticate the caller of a method and check it is allowed to call it,
+// returning the context carrying the principal.
+func (a *Authorizer) Authorize(ctx context.Context, method string) (context.Context, error) {
+	a.mu.RLock()
+	policy := a.policy
+	a.mu.RUnlock()
+
+	token, found := bearerToken(ctx)
+	if !found {
+		if policy.anonymous.allows(method) {
+			return ctx, nil
+		}
+		return nil, status.Error(codes.Unauthenticated, "missing bearer token")
+	}
+	p, found := policy.principals[sha256.Sum256([]byte(token))]
+	if !found {
+		return nil, status.Error(codes.Unauthenticated, "unknown bearer token")
+	}
+	if !p.methods.allows(method) {
+		return nil, status.Errorf(codes.PermissionDenied, "%s is not allowed to call %s", p.name, method)
+	}
+	return context.WithValue(ctx, principalKey{}, p.name), nil
+}
+
+func (a *Authorizer) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := a.Authorize(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+type authorizedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authorizedStream) Context() context.Context {
+	return s.ctx
+}
+
+func (a *Authorizer) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := a.Authorize(ss.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &authorizedStream{ss, ctx})
+	}
+}
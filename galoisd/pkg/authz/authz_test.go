@@ -0,0 +1,155 @@
+package authz
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	prove  = "/union.galois.api.v3.UnionProverAPI/Poll"
+	verify = "/union.galois.api.v3.UnionProverAPI/Verify"
+	drain  = "/union.galois.api.v3.UnionProverAdminAPI/ScheduleMaintenance"
+	health = "/grpc.health.v1.Health/Check"
+)
+
+func tokenHash(token string) string {
+	h := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(h[:])
+}
+
+func writePolicy(t *testing.T, path string, policy Policy, modTime time.Time) {
+	bz, err := json.Marshal(policy)
+	assert.NoError(t, err)
+	assert.NoError(t, os.WriteFile(path, bz, 0600))
+	assert.NoError(t, os.Chtimes(path, modTime, modTime))
+}
+
+func withToken(token string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+}
+
+func code(err error) codes.Code {
+	return status.Code(err)
+}
+
+func testPolicy() Policy {
+	return Policy{
+		Principals: map[string]Principal{
+			"relayer": {
+				TokenSHA256: tokenHash("relayer-token"),
+				Methods:     []string{"/union.galois.api.v3.UnionProverAPI/*"},
+			},
+			"monitor": {
+				TokenSHA256: tokenHash("monitor-token"),
+				Methods:     []string{verify},
+			},
+			"ops": {
+				TokenSHA256: tokenHash("ops-token"),
+				Methods:     []string{"*"},
+			},
+		},
+		Anonymous: []string{"/grpc.health.v1.Health/*"},
+	}
+}
+
+func TestAuthorize(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "policy.json")
+	writePolicy(t, path, testPolicy(), time.Unix(1, 0))
+	a, err := New(path)
+	assert.NoError(t, err)
+
+	ctx, err := a.Authorize(withToken("relayer-token"), prove)
+	assert.NoError(t, err)
+	principal, ok := PrincipalFromContext(ctx)
+	assert.True(t, ok)
+	assert.Equal(t, "relayer", principal)
+	_, err = a.Authorize(withToken("relayer-token"), drain)
+	assert.Equal(t, codes.PermissionDenied, code(err))
+
+	_, err = a.Authorize(withToken("monitor-token"), verify)
+	assert.NoError(t, err)
+	_, err = a.Authorize(withToken("monitor-token"), prove)
+	assert.Equal(t, codes.PermissionDenied, code(err))
+
+	_, err = a.Authorize(withToken("ops-token"), drain)
+	assert.NoError(t, err)
+
+	_, err = a.Authorize(withToken("forged-token"), verify)
+	assert.Equal(t, codes.Unauthenticated, code(err))
+
+	ctx, err = a.Authorize(context.Background(), health)
+	assert.NoError(t, err)
+	_, ok = PrincipalFromContext(ctx)
+	assert.False(t, ok)
+	_, err = a.Authorize(context.Background(), verify)
+	assert.Equal(t, codes.Unauthenticated, code(err))
+}
+
+func TestServicePatternIsNotAPrefix(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "policy.json")
+	writePolicy(t, path, testPolicy(), time.Unix(1, 0))
+	a, err := New(path)
+	assert.NoError(t, err)
+	// A service pattern doesn't match the services sharing its prefix
+	_, err = a.Authorize(withToken("relayer-token"), "/union.galois.api.v3.UnionProverAPIExtra/Poll")
+	assert.Equal(t, codes.PermissionDenied, code(err))
+}
+
+func TestReload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "policy.json")
+	policy := testPolicy()
+	writePolicy(t, path, policy, time.Unix(1, 0))
+	a, err := New(path)
+	assert.NoError(t, err)
+
+	reloaded, err := a.Reload()
+	assert.NoError(t, err)
+	assert.False(t, reloaded)
+
+	monitor := policy.Principals["monitor"]
+	monitor.Methods = append(monitor.Methods, prove)
+	policy.Principals["monitor"] = monitor
+	writePolicy(t, path, policy, time.Unix(2, 0))
+	reloaded, err = a.Reload()
+	assert.NoError(t, err)
+	assert.True(t, reloaded)
+	_, err = a.Authorize(withToken("monitor-token"), prove)
+	assert.NoError(t, err)
+
+	// An invalid policy is rejected and the current one kept
+	assert.NoError(t, os.WriteFile(path, []byte("{"), 0600))
+	assert.NoError(t, os.Chtimes(path, time.Unix(3, 0), time.Unix(3, 0)))
+	_, err = a.Reload()
+	assert.Error(t, err)
+	_, err = a.Authorize(withToken("monitor-token"), prove)
+	assert.NoError(t, err)
+}
+
+func TestInvalidPolicy(t *testing.T) {
+	_, err := compile(Policy{Principals: map[string]Principal{
+		"relayer": {TokenSHA256: "not hex", Methods: []string{"*"}},
+	}})
+	assert.Error(t, err)
+
+	_, err = compile(Policy{Principals: map[string]Principal{
+		"relayer": {TokenSHA256: tokenHash("token"), Methods: []string{"Poll"}},
+	}})
+	assert.Error(t, err)
+
+	_, err = compile(Policy{Principals: map[string]Principal{
+		"a": {TokenSHA256: tokenHash("token"), Methods: []string{"*"}},
+		"b": {TokenSHA256: tokenHash("token"), Methods: []string{"*"}},
+	}})
+	assert.Error(t, err)
+}
@@ -0,0 +1,185 @@
+// Package clockskew measures the offset of the local clock against NTP servers
+// with SNTP (RFC 4330), such that a prover whose clock drifted is noticed
+// before its timestamp checks and audit logs become unreliable.
+package clockskew
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	packetSize = 48
+	// Seconds between the NTP (1900) and Unix (1970) epochs
+	ntpEpochOffset = 2208988800
+	defaultPort    = "123"
+	// Version 4, client mode
+	clientHeader = 4<<3 | 3
+)
+
+// Offset of the local clock against a server, to be added to the local time to
+// get the server one.
+type Sample struct {
+	Offset time.Duration
+	// Round trip time, bounding the error of the offset
+	RTT time.Duration
+}
+
+func toNTP(t time.Time) uint64 {
+	secs := uint64(t.Unix() + ntpEpochOffset)
+	frac := (uint64(t.Nanosecond()) << 32) / 1e9
+	return secs<<32 | frac
+}
+
+func fromNTP(ts uint64) time.Time {
+	secs := int64(ts>>32) - ntpEpochOffset
+	nanos := (int64(ts&0xffffffff) * 1e9) >> 32
+	return time.Unix(secs, nanos)
+}
+
+// Query an NTP server, given as host or host:port.
+func Query(ctx context.Context, server string) (Sample, error) {
+	if _, _, err := net.SplitHostPort(server); err != nil {
+		server = net.JoinHostPort(server, defaultPort)
+	}
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "udp", server)
+	if err != nil {
+		return Sample{}, err
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+
+	request := make([]byte, packetSize)
+	request[0] = clientHeader
+	sentAt := time.Now()
+	originate := toNTP(sentAt)
+	binary.BigEndian.PutUint64(request[40:], originate)
+	if _, err := conn.Write(request); err != nil {
+		return Sample{}, err
+	}
+	response := make([]byte, packetSize)
+	n, err := conn.Read(response)
+	if err != nil {
+		return Sample{}, err
+	}
+	receivedAt := sentAt.Add(time.Since(sentAt))
+	if n < packetSize {
+		return Sample{}, fmt.Errorf("Short NTP response of %d bytes", n)
+	}
+
+	leap, mode, stratum := response[0]>>6, response[0]&0x7, response[1]
+	if mode != 4 && mode != 5 {
+		return Sample{}, fmt.Errorf("Unexpected NTP mode %d", mode)
+	}
+	if stratum == 0 || stratum >= 16 {
+		return Sample{}, fmt.Errorf("NTP server refused the request or is unsynchronized, stratum %d", stratum)
+	}
+	if leap == 3 {
+		return Sample{}, errors.New("NTP server is unsynchronized")
+	}
+	if binary.BigEndian.Uint64(response[24:]) != originate {
+		return Sample{}, errors.New("NTP response doesn't answer the request")
+	}
+	received := fromNTP(binary.BigEndian.Uint64(response[32:]))
+	transmitted := fromNTP(binary.BigEndian.Uint64(response[40:]))
+	return Sample{
+		Offset: (received.Sub(sentAt) + transmitted.Sub(receivedAt)) / 2,
+		RTT:    receivedAt.Sub(sentAt) - transmitted.Sub(received),
+	}, nil
+}
+
+// Result of the last check.
+type Status struct {
+	// Median offset of the reachable sources
+	Offset    time.Duration
+	Reachable int
+	Sources   int
+	CheckedAt time.Time
+	// Whether the offset is within the tolerated skew
+	Healthy bool
+}
+
+type Monitor struct {
+	sources []string
+	maxSkew time.Duration
+	timeout time.Duration
+	query   func(ctx context.Context, server string) (Sample, error)
+
+	mu     sync.RWMutex
+	status Status
+}
+
+// Monitor the local clock against the given sources, tolerating maxSkew.
+func New(sources []string, maxSkew time.Duration, timeout time.Duration) *Monitor {
+	return &Monitor{
+		sources: sources,
+		maxSkew: maxSkew,
+		timeout: timeout,
+		query:   Query,
+	}
+}
+
+// Query all the sources. The clock is unhealthy if no source is reachable, a
+// prover unable to tell its skew being treated like a skewed one.
+func (m *Monitor) Check(ctx context.Context) (Status, error) {
+	ctx, cancel := context.WithTimeout(ctx, m.timeout)
+	defer cancel()
+	offsets := make(chan time.Duration, len(m.sources))
+	errs := make(chan error, len(m.sources))
+	for _, source := range m.sources {
+		go func(source string) {
+			sample, err := m.query(ctx, source)
+			if err != nil {
+				errs <- fmt.Errorf("%s: %w", source, err)
+				return
+			}
+			offsets <- sample.Offset
+		}(source)
+	}
+	var measured []time.Duration
+	var failures []error
+	for range m.sources {
+		select {
+		case offset := <-offsets:
+			measured = append(measured, offset)
+		case err := <-errs:
+			failures = append(failures, err)
+		}
+	}
+
+	status := Status{
+		Reachable: len(measured),
+		Sources:   len(m.sources),
+		CheckedAt: time.Now(),
+	}
+	if len(measured) > 0 {
+		sort.Slice(measured, func(i, j int) bool { return measured[i] < measured[j] })
+		status.Offset = measured[len(measured)/2]
+		status.Healthy = status.Offset <= m.maxSkew && status.Offset >= -m.maxSkew
+	}
+	m.mu.Lock()
+	m.status = status
+	m.mu.Unlock()
+	return status, errors.Join(failures...)
+}
+
+// Result of the last check, the zero status before the first one.
+func (m *Monitor) Status() Status {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.status
+}
+
+// The local time corrected by the measured offset.
+func (m *Monitor) Now() time.Time {
+	return time.Now().Add(m.Status().Offset)
+}
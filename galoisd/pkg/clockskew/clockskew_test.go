@@ -0,0 +1,87 @@
+package clockskew
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Serve SNTP from a clock ahead of the local one by offset.
+func fakeServer(t *testing.T, offset time.Duration, stratum byte) string {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	go func() {
+		request := make([]byte, packetSize)
+		for {
+			_, addr, err := conn.ReadFrom(request)
+			if err != nil {
+				return
+			}
+			now := time.Now().Add(offset)
+			response := make([]byte, packetSize)
+			response[0] = 4<<3 | 4
+			response[1] = stratum
+			copy(response[24:32], request[40:48])
+			binary.BigEndian.PutUint64(response[32:], toNTP(now))
+			binary.BigEndian.PutUint64(response[40:], toNTP(now))
+			conn.WriteTo(response, addr)
+		}
+	}()
+	return conn.LocalAddr().String()
+}
+
+func TestNTPTimestamps(t *testing.T) {
+	now := time.Unix(1700000000, 123456789)
+	assert.WithinDuration(t, now, fromNTP(toNTP(now)), time.Nanosecond)
+}
+
+func TestQuery(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	sample, err := Query(ctx, fakeServer(t, 3*time.Second, 2))
+	assert.NoError(t, err)
+	assert.InDelta(t, float64(3*time.Second), float64(sample.Offset), float64(50*time.Millisecond))
+	assert.Less(t, sample.RTT, 50*time.Millisecond)
+
+	// Kiss of death
+	_, err = Query(ctx, fakeServer(t, 0, 0))
+	assert.Error(t, err)
+}
+
+func TestCheck(t *testing.T) {
+	offsets := map[string]time.Duration{"a": 100 * time.Millisecond, "b": 200 * time.Millisecond, "c": 5 * time.Second}
+	m := New([]string{"a", "b", "c", "down"}, time.Second, time.Second)
+	m.query = func(ctx context.Context, server string) (Sample, error) {
+		offset, found := offsets[server]
+		if !found {
+			return Sample{}, errors.New("timeout")
+		}
+		return Sample{Offset: offset}, nil
+	}
+
+	// A single falseticker doesn't move the median
+	status, err := m.Check(context.Background())
+	assert.Error(t, err)
+	assert.Equal(t, 3, status.Reachable)
+	assert.Equal(t, 4, status.Sources)
+	assert.Equal(t, 200*time.Millisecond, status.Offset)
+	assert.True(t, status.Healthy)
+	assert.Equal(t, status, m.Status())
+
+	offsets["a"], offsets["b"] = -2*time.Second, -3*time.Second
+	status, _ = m.Check(context.Background())
+	assert.Equal(t, -2*time.Second, status.Offset)
+	assert.False(t, status.Healthy)
+
+	// Unable to tell the skew
+	offsets = nil
+	status, _ = m.Check(context.Background())
+	assert.Equal(t, 0, status.Reachable)
+	assert.False(t, status.Healthy)
+}
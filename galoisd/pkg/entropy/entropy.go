@@ -0,0 +1,141 @@
+// Package entropy mixes the output of a hardware randomness source, such as
+// /dev/hwrng, into the runtime CSPRNG. The source is continuously checked with
+// the health tests of NIST SP 800-90B and reading fails as soon as it degrades,
+// such that no proof is blinded with bad randomness.
+package entropy
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+)
+
+var ErrHealthTest = errors.New("entropy source failed its health test")
+
+// The runtime CSPRNG, captured before Install replaces it.
+var runtimeReader = rand.Reader
+
+const (
+	// Assumed min-entropy of a byte of the source, conservative as the
+	// hardware sources are usually whitened.
+	minEntropyBits = 1
+	// Repetition count cutoff, 1 + ceil(20 / H) for a false positive
+	// probability of 2^-20.
+	repetitionCutoff = 1 + (20+minEntropyBits-1)/minEntropyBits
+	// Adaptive proportion window and cutoff for H = 1 and the same
+	// false positive probability.
+	proportionWindow = 512
+	proportionCutoff = 410
+	// Read and tested once before the source is used.
+	startupSamples = 4096
+)
+
+type Source struct {
+	mu     sync.Mutex
+	source io.Reader
+	failed bool
+
+	// Repetition count test
+	lastSample  byte
+	repetitions int
+	// Adaptive proportion test
+	windowSample byte
+	windowCount  int
+	windowSize   int
+}
+
+// Open a device or file as entropy source, running the startup health test.
+func Open(path string) (*Source, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("Could not open entropy source %s", err)
+	}
+	s, err := New(f)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+func New(source io.Reader) (*Source, error) {
+	s := &Source{source: source}
+	startup := make([]byte, startupSamples)
+	if err := s.readTested(startup); err != nil {
+		return nil, fmt.Errorf("Entropy source failed its startup test %w", err)
+	}
+	return s, nil
+}
+
+func (s *Source) test(sample byte) bool {
+	if s.repetitions > 0 && sample == s.lastSample {
+		s.repetitions++
+	} else {
+		s.lastSample = sample
+		s.repetitions = 1
+	}
+	if s.repetitions >= repetitionCutoff {
+		return false
+	}
+
+	if s.windowSize == 0 {
+		s.windowSample = sample
+		s.windowCount = 0
+	}
+	if sample == s.windowSample {
+		s.windowCount++
+	}
+	s.windowSize++
+	if s.windowCount >= proportionCutoff {
+		return false
+	}
+	if s.windowSize == proportionWindow {
+		s.windowSize = 0
+	}
+	return true
+}
+
+// Read from the source, failing for good once a health test failed.
+func (s *Source) readTested(p []byte) error {
+	if s.failed {
+		return ErrHealthTest
+	}
+	if _, err := io.ReadFull(s.source, p); err != nil {
+		return fmt.Errorf("Could not read entropy source %s", err)
+	}
+	for _, sample := range p {
+		if !s.test(sample) {
+			s.failed = true
+			return ErrHealthTest
+		}
+	}
+	return nil
+}
+
+// Read the source XORed with the runtime CSPRNG, such that the output is never
+// weaker than either of them.
+func (s *Source) Read(p []byte) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	hw := make([]byte, len(p))
+	if err := s.readTested(hw); err != nil {
+		return 0, err
+	}
+	if _, err := io.ReadFull(runtimeReader, p); err != nil {
+		return 0, err
+	}
+	for i := range p {
+		p[i] ^= hw[i]
+	}
+	return len(p), nil
+}
+
+// Install the source as crypto/rand.Reader, used by gnark to sample the proof
+// blinding factors. Note that crypto/rand.Read aborts the process if the source
+// fails.
+func Install(s *Source) {
+	rand.Reader = s
+}
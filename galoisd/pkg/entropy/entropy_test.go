@@ -0,0 +1,68 @@
+package entropy
+
+import (
+	"bytes"
+	"crypto/rand"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHealthySource(t *testing.T) {
+	s, err := New(rand.Reader)
+	require.NoError(t, err)
+	p := make([]byte, 1<<16)
+	n, err := s.Read(p)
+	require.NoError(t, err)
+	assert.Equal(t, len(p), n)
+	assert.NotEqual(t, make([]byte, len(p)), p)
+}
+
+func TestStuckSourceFailsStartup(t *testing.T) {
+	_, err := New(bytes.NewReader(make([]byte, startupSamples)))
+	assert.ErrorIs(t, err, ErrHealthTest)
+}
+
+func TestBiasedSourceFailsStartup(t *testing.T) {
+	// No long run of a value, but one of them is way too frequent.
+	biased := make([]byte, startupSamples)
+	for i := range biased {
+		if i%8 != 3 {
+			biased[i] = 0xAA
+		} else {
+			biased[i] = byte(i)
+		}
+	}
+	_, err := New(bytes.NewReader(biased))
+	assert.ErrorIs(t, err, ErrHealthTest)
+}
+
+type degradingReader struct {
+	healthy int
+}
+
+func (r *degradingReader) Read(p []byte) (int, error) {
+	if r.healthy <= 0 {
+		for i := range p {
+			p[i] = 0
+		}
+		return len(p), nil
+	}
+	n := min(len(p), r.healthy)
+	r.healthy -= n
+	return io.ReadFull(rand.Reader, p[:n])
+}
+
+func TestSourceFailsClosed(t *testing.T) {
+	s, err := New(&degradingReader{healthy: startupSamples})
+	require.NoError(t, err)
+	p := make([]byte, 64)
+	_, err = s.Read(p)
+	assert.ErrorIs(t, err, ErrHealthTest)
+	// Further reads keep failing even if the source recovered.
+	s.source = rand.Reader
+	_, err = s.Read(p)
+	assert.ErrorIs(t, err, ErrHealthTest)
+}
@@ -0,0 +1,179 @@
+// Package fairqueue implements start-time fair queuing across clients: each
+// client is served in proportion to its weight, such that one client submitting
+// hundreds of items can't starve the others.
+package fairqueue
+
+import (
+	"sort"
+	"sync"
+)
+
+type item[K comparable, V any] struct {
+	key   K
+	value V
+	// Virtual time at which the item starts being served
+	start float64
+}
+
+type Queue[K comparable, V any] struct {
+	mu            sync.Mutex
+	capacity      int
+	weights       map[string]float64
+	defaultWeight float64
+
+	virtualTime float64
+	// Virtual time at which the last queued item of a client is served
+	lastFinish map[string]float64
+	clients    map[string][]*item[K, V]
+	size       int
+}
+
+// Create a queue holding up to capacity items. Clients missing from weights get
+// the default weight.
+func New[K comparable, V any](capacity int, weights map[string]float64, defaultWeight float64) *Queue[K, V] {
+	return &Queue[K, V]{
+		capacity:      capacity,
+		weights:       weights,
+		defaultWeight: defaultWeight,
+		lastFinish:    make(map[string]float64),
+		clients:       make(map[string][]*item[K, V]),
+	}
+}
+
+func (q *Queue[K, V]) weight(client string) float64 {
+	if weight, found := q.weights[client]; found && weight > 0 {
+		return weight
+	}
+	if q.defaultWeight > 0 {
+		return q.defaultWeight
+	}
+	return 1
+}
+
+// Queue an item of a client, returning false if the queue is full.
+func (q *Queue[K, V]) Push(client string, key K, value V) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.size >= q.capacity {
+		return false
+	}
+	start := max(q.virtualTime, q.lastFinish[client])
+	q.lastFinish[client] = start + 1/q.weight(client)
+	q.clients[client] = append(q.clients[client], &item[K, V]{
+		key:   key,
+		value: value,
+		start: start,
+	})
+	q.size++
+	return true
+}
+
+// Dequeue the item with the earliest virtual start time.
+func (q *Queue[K, V]) Pop() (K, V, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	var (
+		next      *item[K, V]
+		nextOwner string
+	)
+	for client, items := range q.clients {
+		head := items[0]
+		// Break ties by client to stay deterministic
+		if next == nil || head.start < next.start || (head.start == next.start && client < nextOwner) {
+			next, nextOwner = head, client
+		}
+	}
+	if next == nil {
+		var key K
+		var value V
+		return key, value, false
+	}
+	q.virtualTime = next.start
+	q.dequeue(nextOwner, 0)
+	q.prune()
+	return next.key, next.value, true
+}
+
+// Dequeue the earliest item accepted, e.g. the first one resources are
+// available for. Items are offered in the order Pop would return them, and
+// accept may commit resources as the item is dequeued as soon as it returns
+// true.
+func (q *Queue[K, V]) PopIf(accept func(K, V) bool) (K, V, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	type candidate struct {
+		client string
+		index  int
+		item   *item[K, V]
+	}
+	candidates := make([]candidate, 0, q.size)
+	for client, items := range q.clients {
+		for i, it := range items {
+			candidates = append(candidates, candidate{client, i, it})
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if a.item.start != b.item.start {
+			return a.item.start < b.item.start
+		}
+		if a.client != b.client {
+			return a.client < b.client
+		}
+		return a.index < b.index
+	})
+	for _, c := range candidates {
+		if accept(c.item.key, c.item.value) {
+			// Skipped items keep their start time and are served first
+			q.virtualTime = max(q.virtualTime, c.item.start)
+			q.dequeue(c.client, c.index)
+			q.prune()
+			return c.item.key, c.item.value, true
+		}
+	}
+	var key K
+	var value V
+	return key, value, false
+}
+
+func (q *Queue[K, V]) dequeue(client string, i int) {
+	items := q.clients[client]
+	items = append(items[:i], items[i+1:]...)
+	if len(items) == 0 {
+		delete(q.clients, client)
+	} else {
+		q.clients[client] = items
+	}
+	q.size--
+}
+
+// Forget the clients without queued items that caught up with the virtual time,
+// they would start at the virtual time anyway.
+func (q *Queue[K, V]) prune() {
+	for client, finish := range q.lastFinish {
+		if _, queued := q.clients[client]; !queued && finish <= q.virtualTime {
+			delete(q.lastFinish, client)
+		}
+	}
+}
+
+// Remove a queued item, returning false if it isn't queued.
+func (q *Queue[K, V]) Remove(key K) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for client, items := range q.clients {
+		for i, it := range items {
+			if it.key == key {
+				q.dequeue(client, i)
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (q *Queue[K, V]) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.size
+}
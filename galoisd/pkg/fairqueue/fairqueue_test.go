@@ -0,0 +1,106 @@
+package fairqueue
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func drain(q *Queue[int, string]) []string {
+	var clients []string
+	for {
+		_, client, ok := q.Pop()
+		if !ok {
+			return clients
+		}
+		clients = append(clients, client)
+	}
+}
+
+func TestBacklogDoesNotStarve(t *testing.T) {
+	q := New[int, string](1000, nil, 1)
+	for i := 0; i < 100; i++ {
+		assert.True(t, q.Push("relayer-a", i, "relayer-a"))
+	}
+	assert.True(t, q.Push("relayer-b", 100, "relayer-b"))
+	order := drain(q)
+	assert.Len(t, order, 101)
+	assert.Contains(t, order[:2], "relayer-b")
+}
+
+func TestLateClientIsServedNext(t *testing.T) {
+	q := New[int, string](1000, nil, 1)
+	for i := 0; i < 10; i++ {
+		assert.True(t, q.Push("relayer-a", i, "relayer-a"))
+	}
+	for i := 0; i < 5; i++ {
+		_, client, ok := q.Pop()
+		assert.True(t, ok)
+		assert.Equal(t, "relayer-a", client)
+	}
+	// The backlog of relayer-a doesn't count against relayer-b
+	assert.True(t, q.Push("relayer-b", 10, "relayer-b"))
+	order := drain(q)
+	assert.Contains(t, order[:2], "relayer-b")
+}
+
+func TestWeights(t *testing.T) {
+	q := New[int, string](1000, map[string]float64{"relayer-a": 3}, 1)
+	for i := 0; i < 40; i++ {
+		assert.True(t, q.Push("relayer-a", i, "relayer-a"))
+		assert.True(t, q.Push("relayer-b", 100+i, "relayer-b"))
+	}
+	served := map[string]int{}
+	for _, client := range drain(q)[:40] {
+		served[client]++
+	}
+	assert.Equal(t, 30, served["relayer-a"])
+	assert.Equal(t, 10, served["relayer-b"])
+}
+
+func TestCapacity(t *testing.T) {
+	q := New[int, string](2, nil, 1)
+	assert.True(t, q.Push("relayer-a", 0, "relayer-a"))
+	assert.True(t, q.Push("relayer-b", 1, "relayer-b"))
+	assert.False(t, q.Push("relayer-c", 2, "relayer-c"))
+	_, _, ok := q.Pop()
+	assert.True(t, ok)
+	assert.True(t, q.Push("relayer-c", 2, "relayer-c"))
+	assert.Equal(t, 2, q.Len())
+}
+
+func TestRemove(t *testing.T) {
+	q := New[int, string](10, nil, 1)
+	assert.True(t, q.Push("relayer-a", 0, "relayer-a"))
+	assert.True(t, q.Push("relayer-a", 1, "relayer-a"))
+	assert.True(t, q.Remove(0))
+	assert.False(t, q.Remove(0))
+	assert.Equal(t, 1, q.Len())
+	key, _, ok := q.Pop()
+	assert.True(t, ok)
+	assert.Equal(t, 1, key)
+	_, _, ok = q.Pop()
+	assert.False(t, ok)
+}
+
+func TestPopIf(t *testing.T) {
+	q := New[int, string](1000, nil, 1)
+	assert.True(t, q.Push("relayer-a", 0, "mainnet"))
+	assert.True(t, q.Push("relayer-a", 1, "testnet"))
+	assert.True(t, q.Push("relayer-b", 2, "mainnet"))
+
+	// The testnet job skips the mainnet ones waiting for capacity
+	key, _, ok := q.PopIf(func(_ int, circuit string) bool { return circuit == "testnet" })
+	assert.True(t, ok)
+	assert.Equal(t, 1, key)
+	_, _, ok = q.PopIf(func(int, string) bool { return false })
+	assert.False(t, ok)
+
+	// Skipped jobs are served first
+	key, _, ok = q.Pop()
+	assert.True(t, ok)
+	assert.Equal(t, 0, key)
+	key, _, ok = q.Pop()
+	assert.True(t, ok)
+	assert.Equal(t, 2, key)
+}
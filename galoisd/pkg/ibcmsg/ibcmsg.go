@@ -0,0 +1,145 @@
+// Package ibcmsg wraps the proofs produced by galoisd into the messages the
+// Union cometbls IBC light client expects, such that relayers can submit the
+// prover output verbatim. The messages are encoded by hand to avoid pulling
+// ibc-go along with its own cosmos-sdk version.
+package ibcmsg
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	tmtypes "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+const (
+	HeaderTypeURL          = "/union.ibc.lightclients.cometbls.v1.Header"
+	MsgUpdateClientTypeURL = "/ibc.core.client.v1.MsgUpdateClient"
+)
+
+// IBC height, as in ibc.core.client.v1.Height.
+type Height struct {
+	RevisionNumber uint64
+	RevisionHeight uint64
+}
+
+func (h Height) String() string {
+	return fmt.Sprintf("%d-%d", h.RevisionNumber, h.RevisionHeight)
+}
+
+// Parse a height formatted as revision-height, e.g. 1-1042.
+func ParseHeight(s string) (Height, error) {
+	revision, height, found := strings.Cut(s, "-")
+	if !found {
+		return Height{}, fmt.Errorf("Could not parse height %s, expected revision-height", s)
+	}
+	revisionNumber, err := strconv.ParseUint(revision, 10, 64)
+	if err != nil {
+		return Height{}, fmt.Errorf("Could not parse revision number %s", err)
+	}
+	revisionHeight, err := strconv.ParseUint(height, 10, 64)
+	if err != nil {
+		return Height{}, fmt.Errorf("Could not parse revision height %s", err)
+	}
+	return Height{revisionNumber, revisionHeight}, nil
+}
+
+// Revision of a chain ID formatted as name-revision, 0 otherwise.
+func RevisionNumber(chainID string) uint64 {
+	i := strings.LastIndexByte(chainID, '-')
+	if i < 0 {
+		return 0
+	}
+	revision, err := strconv.ParseUint(chainID[i+1:], 10, 64)
+	if err != nil {
+		return 0
+	}
+	return revision
+}
+
+func appendBytes(b []byte, num protowire.Number, v []byte) []byte {
+	if len(v) == 0 {
+		return b
+	}
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendBytes(b, v)
+}
+
+func appendVarint(b []byte, num protowire.Number, v uint64) []byte {
+	if v == 0 {
+		return b
+	}
+	b = protowire.AppendTag(b, num, protowire.VarintType)
+	return protowire.AppendVarint(b, v)
+}
+
+func encodeHeight(h Height) []byte {
+	var b []byte
+	b = appendVarint(b, 1, h.RevisionNumber)
+	b = appendVarint(b, 2, h.RevisionHeight)
+	return b
+}
+
+// Encode the light header of an untrusted header, as in
+// union.ibc.lightclients.cometbls.v1.LightHeader.
+func encodeLightHeader(header *tmtypes.Header) []byte {
+	var timestamp []byte
+	timestamp = appendVarint(timestamp, 1, uint64(header.Time.Unix()))
+	timestamp = appendVarint(timestamp, 2, uint64(header.Time.Nanosecond()))
+
+	var b []byte
+	b = appendVarint(b, 1, uint64(header.Height))
+	b = protowire.AppendTag(b, 2, protowire.BytesType)
+	b = protowire.AppendBytes(b, timestamp)
+	b = appendBytes(b, 3, header.ValidatorsHash)
+	b = appendBytes(b, 4, header.NextValidatorsHash)
+	b = appendBytes(b, 5, header.AppHash)
+	return b
+}
+
+// Encode a cometbls header updating the client from the trusted height to the
+// untrusted header. The zero-knowledge proof is the EVM proof of the response,
+// verified by the light client.
+func Header(header *tmtypes.Header, trustedHeight Height, zkp []byte) ([]byte, error) {
+	if header == nil {
+		return nil, fmt.Errorf("Missing untrusted header")
+	}
+	if len(zkp) == 0 {
+		return nil, fmt.Errorf("Missing zero-knowledge proof")
+	}
+	if trustedHeight.RevisionHeight == 0 {
+		return nil, fmt.Errorf("Missing trusted height")
+	}
+	var b []byte
+	b = protowire.AppendTag(b, 1, protowire.BytesType)
+	b = protowire.AppendBytes(b, encodeLightHeader(header))
+	b = protowire.AppendTag(b, 2, protowire.BytesType)
+	b = protowire.AppendBytes(b, encodeHeight(trustedHeight))
+	b = appendBytes(b, 3, zkp)
+	return b, nil
+}
+
+// Encode a google.protobuf.Any.
+func Any(typeURL string, value []byte) []byte {
+	var b []byte
+	b = appendBytes(b, 1, []byte(typeURL))
+	b = appendBytes(b, 2, value)
+	return b
+}
+
+// Encode a MsgUpdateClient submitting an encoded header to a client.
+func MsgUpdateClient(clientID string, header []byte, signer string) ([]byte, error) {
+	if clientID == "" {
+		return nil, fmt.Errorf("Missing client ID")
+	}
+	if signer == "" {
+		return nil, fmt.Errorf("Missing signer")
+	}
+	var b []byte
+	b = appendBytes(b, 1, []byte(clientID))
+	b = protowire.AppendTag(b, 2, protowire.BytesType)
+	b = protowire.AppendBytes(b, Any(HeaderTypeURL, header))
+	b = appendBytes(b, 3, []byte(signer))
+	return b, nil
+}
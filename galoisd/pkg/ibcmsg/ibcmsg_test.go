@@ -0,0 +1,96 @@
+package ibcmsg
+
+import (
+	"testing"
+	"time"
+
+	tmtypes "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Fields of an encoded message by number, the last occurrence winning.
+func fields(t *testing.T, b []byte) map[protowire.Number][]byte {
+	fields := make(map[protowire.Number][]byte)
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		assert.Greater(t, n, 0)
+		b = b[n:]
+		switch typ {
+		case protowire.BytesType:
+			v, n := protowire.ConsumeBytes(b)
+			assert.Greater(t, n, 0)
+			fields[num] = v
+			b = b[n:]
+		case protowire.VarintType:
+			v, n := protowire.ConsumeVarint(b)
+			assert.Greater(t, n, 0)
+			fields[num] = protowire.AppendVarint(nil, v)
+			b = b[n:]
+		default:
+			t.Fatalf("unexpected wire type %d", typ)
+		}
+	}
+	return fields
+}
+
+func varint(b []byte) uint64 {
+	v, _ := protowire.ConsumeVarint(b)
+	return v
+}
+
+func TestParseHeight(t *testing.T) {
+	height, err := ParseHeight("1-1042")
+	assert.NoError(t, err)
+	assert.Equal(t, Height{1, 1042}, height)
+	assert.Equal(t, "1-1042", height.String())
+	_, err = ParseHeight("1042")
+	assert.Error(t, err)
+	assert.Equal(t, uint64(1337), RevisionNumber("union-devnet-1337"))
+	assert.Equal(t, uint64(0), RevisionNumber("union"))
+}
+
+func TestMsgUpdateClient(t *testing.T) {
+	now := time.Unix(1700000000, 42)
+	header := &tmtypes.Header{
+		Height:             0xCAFEBABE,
+		Time:               now,
+		ValidatorsHash:     []byte{1},
+		NextValidatorsHash: []byte{2},
+		AppHash:            []byte{3},
+	}
+	zkp := []byte{0xde, 0xad}
+	encoded, err := Header(header, Height{1, 100}, zkp)
+	assert.NoError(t, err)
+	msg, err := MsgUpdateClient("08-cometbls-0", encoded, "union1relayer")
+	assert.NoError(t, err)
+
+	update := fields(t, msg)
+	assert.Equal(t, "08-cometbls-0", string(update[1]))
+	assert.Equal(t, "union1relayer", string(update[3]))
+	var wrapped anypb.Any
+	assert.NoError(t, proto.Unmarshal(update[2], &wrapped))
+	assert.Equal(t, HeaderTypeURL, wrapped.TypeUrl)
+
+	decoded := fields(t, wrapped.Value)
+	assert.Equal(t, zkp, decoded[3])
+	trusted := fields(t, decoded[2])
+	assert.Equal(t, uint64(1), varint(trusted[1]))
+	assert.Equal(t, uint64(100), varint(trusted[2]))
+	light := fields(t, decoded[1])
+	assert.Equal(t, uint64(0xCAFEBABE), varint(light[1]))
+	assert.Equal(t, []byte{1}, light[3])
+	assert.Equal(t, []byte{2}, light[4])
+	assert.Equal(t, []byte{3}, light[5])
+	var timestamp timestamppb.Timestamp
+	assert.NoError(t, proto.Unmarshal(light[2], &timestamp))
+	assert.True(t, now.Equal(timestamp.AsTime()))
+
+	_, err = Header(header, Height{}, zkp)
+	assert.Error(t, err)
+	_, err = MsgUpdateClient("", encoded, "union1relayer")
+	assert.Error(t, err)
+}
@@ -12,9 +12,6 @@ import (
 	"github.com/consensys/gnark/std/math/emulated"
 )
 
-// Max number of validators the light client can handle
-const MaxVal = 128
-
 type Validator struct {
 	HashableX    frontend.Variable
 	HashableXMSB frontend.Variable
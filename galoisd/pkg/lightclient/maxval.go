@@ -0,0 +1,10 @@
+//go:build !test_circuit
+// +build !test_circuit
+
+package lightclient
+
+// Max number of validators the light client can handle
+const MaxVal = 128
+
+// Name of the circuit profile the binary is built for.
+const Profile = "production"
@@ -0,0 +1,11 @@
+//go:build test_circuit
+// +build test_circuit
+
+package lightclient
+
+// The test circuit is structurally identical to the production one, only
+// handling fewer validators such that its keys are generated and its proofs
+// computed quickly. It must never be used to secure a light client.
+const MaxVal = 4
+
+const Profile = "test"
@@ -1,3 +1,6 @@
+//go:build !test_circuit
+// +build !test_circuit
+
 package nonadjacent
 
 import (
@@ -0,0 +1,262 @@
+package listener
+
+import (
+	"errors"
+	"math"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	rejectedAccepts = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "galoisd",
+		Subsystem: "listener",
+		Name:      "rejected_accepts_total",
+		Help:      "Number of accepted connections closed before being handed to the server.",
+	}, []string{"reason"})
+	activeConnections = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "galoisd",
+		Subsystem: "listener",
+		Name:      "active_connections",
+		Help:      "Number of connections currently handed to the server.",
+	})
+)
+
+const (
+	reasonRate    = "rate"
+	reasonMaxConn = "max_conn"
+)
+
+// Default number of accepted connections waiting to be admitted.
+const DefaultBacklog = 1024
+
+type Config struct {
+	// Number of connections admitted per second, zero disables rate shaping.
+	Rate float64
+	// Number of connections that can be admitted at once above the rate.
+	Burst int
+	// Maximum number of concurrent connections, zero means unlimited.
+	MaxConn int
+	// Maximum number of accepted connections waiting to be admitted, further
+	// connections are left in the kernel accept queue. DefaultBacklog if zero.
+	Backlog int
+	// How long a connection can wait to be admitted before being closed, zero
+	// means connections wait until admitted.
+	Timeout time.Duration
+}
+
+// A listener that admits connections according to a token bucket and a
+// concurrency limit. Connections are delayed until admitted, up to Backlog of
+// them being held while the others wait in the kernel accept queue, as with
+// netutil.LimitListener. Only the connections that can't be admitted within the
+// configured timeout, if any, are closed and accounted as rejected.
+type shapedListener struct {
+	net.Listener
+	config  Config
+	bucket  *tokenBucket
+	slots   chan struct{}
+	pending chan struct{}
+	conns   chan net.Conn
+	errs    chan error
+	done    chan struct{}
+	once    sync.Once
+}
+
+func New(inner net.Listener, config Config) net.Listener {
+	backlog := config.Backlog
+	if backlog <= 0 {
+		backlog = DefaultBacklog
+	}
+	l := &shapedListener{
+		Listener: inner,
+		config:   config,
+		bucket:   newTokenBucket(config.Rate, config.Burst, time.Now),
+		pending:  make(chan struct{}, backlog),
+		conns:    make(chan net.Conn),
+		errs:     make(chan error, 1),
+		done:     make(chan struct{}),
+	}
+	if config.MaxConn > 0 {
+		l.slots = make(chan struct{}, config.MaxConn)
+	}
+	go l.acceptLoop()
+	return l
+}
+
+func (l *shapedListener) acceptLoop() {
+	var backoff acceptBackoff
+	for {
+		// Stop accepting while the backlog is full, the kernel queues the
+		// incoming connections meanwhile.
+		select {
+		case l.pending <- struct{}{}:
+		case <-l.done:
+			l.errs <- net.ErrClosed
+			return
+		}
+		conn, err := l.Listener.Accept()
+		if err != nil {
+			<-l.pending
+			// Only a closed listener stops the loop, the other errors, e.g.
+			// running out of file descriptors during a reconnect storm, are
+			// retried such that the server keeps accepting once they clear.
+			if errors.Is(err, net.ErrClosed) || !backoff.wait(l.done) {
+				l.errs <- net.ErrClosed
+				return
+			}
+			continue
+		}
+		backoff.reset()
+		go l.admit(conn)
+	}
+}
+
+// Delay before accepting again after an error, doubling from 5ms up to a
+// second as net/http.Server does.
+type acceptBackoff struct {
+	delay time.Duration
+}
+
+// Sleep for the next delay, returning false if done is closed meanwhile.
+func (b *acceptBackoff) wait(done <-chan struct{}) bool {
+	if b.delay == 0 {
+		b.delay = 5 * time.Millisecond
+	} else {
+		b.delay = min(2*b.delay, time.Second)
+	}
+	select {
+	case <-time.After(b.delay):
+		return true
+	case <-done:
+		return false
+	}
+}
+
+func (b *acceptBackoff) reset() {
+	b.delay = 0
+}
+
+func (l *shapedListener) admit(conn net.Conn) {
+	defer func() { <-l.pending }()
+	maxWait := time.Duration(math.MaxInt64)
+	var expired <-chan time.Time
+	if l.config.Timeout > 0 {
+		maxWait = l.config.Timeout
+		timer := time.NewTimer(l.config.Timeout)
+		defer timer.Stop()
+		expired = timer.C
+	}
+	wait, ok := l.bucket.reserve(maxWait)
+	if !ok {
+		rejectedAccepts.WithLabelValues(reasonRate).Inc()
+		conn.Close()
+		return
+	}
+	if wait > 0 {
+		select {
+		case <-time.After(wait):
+		case <-l.done:
+			conn.Close()
+			return
+		}
+	}
+	release := func() {}
+	if l.slots != nil {
+		select {
+		case l.slots <- struct{}{}:
+			release = func() { <-l.slots }
+		case <-expired:
+			rejectedAccepts.WithLabelValues(reasonMaxConn).Inc()
+			conn.Close()
+			return
+		case <-l.done:
+			conn.Close()
+			return
+		}
+	}
+	select {
+	case l.conns <- &admittedConn{Conn: conn, release: release}:
+		activeConnections.Inc()
+	case <-l.done:
+		release()
+		conn.Close()
+	}
+}
+
+func (l *shapedListener) Accept() (net.Conn, error) {
+	select {
+	case conn := <-l.conns:
+		return conn, nil
+	case err := <-l.errs:
+		// Keep returning the error to subsequent calls
+		l.errs <- err
+		return nil, err
+	}
+}
+
+func (l *shapedListener) Close() error {
+	l.once.Do(func() { close(l.done) })
+	return l.Listener.Close()
+}
+
+type admittedConn struct {
+	net.Conn
+	release func()
+	once    sync.Once
+}
+
+func (c *admittedConn) Close() error {
+	err := c.Conn.Close()
+	c.once.Do(func() {
+		activeConnections.Dec()
+		c.release()
+	})
+	return err
+}
+
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+	now    func() time.Time
+}
+
+func newTokenBucket(rate float64, burst int, now func() time.Time) *tokenBucket {
+	b := float64(max(burst, 1))
+	return &tokenBucket{
+		rate:   rate,
+		burst:  b,
+		tokens: b,
+		last:   now(),
+		now:    now,
+	}
+}
+
+// Consume a token, returning how long the caller must wait before it becomes
+// available. The token is not consumed if the wait would exceed maxWait.
+func (b *tokenBucket) reserve(maxWait time.Duration) (time.Duration, bool) {
+	if b.rate <= 0 {
+		return 0, true
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	now := b.now()
+	b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	b.last = now
+	if b.tokens >= 1 {
+		b.tokens--
+		return 0, true
+	}
+	wait := time.Duration((1 - b.tokens) / b.rate * float64(time.Second))
+	if wait > maxWait {
+		return 0, false
+	}
+	b.tokens--
+	return wait, true
+}
@@ -0,0 +1,176 @@
+package listener
+
+import (
+	"net"
+	"os"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenBucketBurst(t *testing.T) {
+	now := time.Unix(0, 0)
+	bucket := newTokenBucket(1, 3, func() time.Time { return now })
+	for i := 0; i < 3; i++ {
+		wait, ok := bucket.reserve(0)
+		assert.True(t, ok)
+		assert.Equal(t, time.Duration(0), wait)
+	}
+	_, ok := bucket.reserve(0)
+	assert.False(t, ok)
+}
+
+func TestTokenBucketWait(t *testing.T) {
+	now := time.Unix(0, 0)
+	bucket := newTokenBucket(2, 1, func() time.Time { return now })
+	wait, ok := bucket.reserve(time.Second)
+	assert.True(t, ok)
+	assert.Equal(t, time.Duration(0), wait)
+	wait, ok = bucket.reserve(time.Second)
+	assert.True(t, ok)
+	assert.Equal(t, 500*time.Millisecond, wait)
+	wait, ok = bucket.reserve(time.Second)
+	assert.True(t, ok)
+	assert.Equal(t, time.Second, wait)
+	_, ok = bucket.reserve(time.Second)
+	assert.False(t, ok)
+}
+
+func TestTokenBucketRefill(t *testing.T) {
+	now := time.Unix(0, 0)
+	bucket := newTokenBucket(1, 2, func() time.Time { return now })
+	bucket.reserve(0)
+	bucket.reserve(0)
+	now = now.Add(10 * time.Second)
+	for i := 0; i < 2; i++ {
+		_, ok := bucket.reserve(0)
+		assert.True(t, ok)
+	}
+	_, ok := bucket.reserve(0)
+	assert.False(t, ok)
+}
+
+func TestTokenBucketUnlimited(t *testing.T) {
+	bucket := newTokenBucket(0, 0, time.Now)
+	for i := 0; i < 100; i++ {
+		_, ok := bucket.reserve(0)
+		assert.True(t, ok)
+	}
+}
+
+func TestListenerMaxConn(t *testing.T) {
+	inner, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	lis := New(inner, Config{MaxConn: 1, Backlog: 4, Timeout: 100 * time.Millisecond})
+	defer lis.Close()
+
+	first, err := net.Dial("tcp", inner.Addr().String())
+	assert.NoError(t, err)
+	defer first.Close()
+	accepted, err := lis.Accept()
+	assert.NoError(t, err)
+
+	second, err := net.Dial("tcp", inner.Addr().String())
+	assert.NoError(t, err)
+	defer second.Close()
+	// The second connection can't get a slot and is dropped after the explicit timeout
+	second.SetReadDeadline(time.Now().Add(time.Second))
+	_, err = second.Read(make([]byte, 1))
+	assert.Error(t, err)
+
+	// Releasing the slot admits the next connection
+	accepted.Close()
+	third, err := net.Dial("tcp", inner.Addr().String())
+	assert.NoError(t, err)
+	defer third.Close()
+	_, err = lis.Accept()
+	assert.NoError(t, err)
+}
+
+func TestListenerStormIsDelayed(t *testing.T) {
+	inner, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	// Far more connections than the backlog and the slots, none may be dropped
+	lis := New(inner, Config{Rate: 200, Burst: 1, MaxConn: 1, Backlog: 2})
+	defer lis.Close()
+
+	const storm = 32
+	clients := make([]net.Conn, storm)
+	for i := range clients {
+		clients[i], err = net.Dial("tcp", inner.Addr().String())
+		assert.NoError(t, err)
+		defer clients[i].Close()
+	}
+	for i := 0; i < storm; i++ {
+		accepted, err := lis.Accept()
+		assert.NoError(t, err)
+		// The connection was handed over open
+		_, err = accepted.Write([]byte{1})
+		assert.NoError(t, err)
+		accepted.Close()
+	}
+	for _, client := range clients {
+		client.SetReadDeadline(time.Now().Add(time.Second))
+		n, _ := client.Read(make([]byte, 1))
+		assert.Equal(t, 1, n)
+	}
+}
+
+func TestListenerCloseUnblocksAccept(t *testing.T) {
+	inner, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	lis := New(inner, Config{MaxConn: 1, Backlog: 1})
+	// Fill the backlog such that the accept loop waits for room
+	for i := 0; i < 3; i++ {
+		client, err := net.Dial("tcp", inner.Addr().String())
+		assert.NoError(t, err)
+		defer client.Close()
+	}
+	accepted, err := lis.Accept()
+	assert.NoError(t, err)
+	defer accepted.Close()
+	time.Sleep(50 * time.Millisecond)
+	lis.Close()
+	_, err = lis.Accept()
+	assert.Error(t, err)
+}
+
+// Listener failing the first accepts as when the process runs out of file
+// descriptors.
+type exhaustedListener struct {
+	net.Listener
+	failures atomic.Int32
+	accepts  atomic.Int32
+}
+
+func (l *exhaustedListener) Accept() (net.Conn, error) {
+	l.accepts.Add(1)
+	if l.failures.Add(-1) >= 0 {
+		return nil, &net.OpError{Op: "accept", Net: "tcp", Err: os.NewSyscallError("accept", syscall.EMFILE)}
+	}
+	return l.Listener.Accept()
+}
+
+func TestListenerRecoversFromAcceptErrors(t *testing.T) {
+	inner, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	exhausted := &exhaustedListener{Listener: inner}
+	exhausted.failures.Store(1)
+	lis := New(exhausted, Config{})
+	defer lis.Close()
+
+	client, err := net.Dial("tcp", inner.Addr().String())
+	assert.NoError(t, err)
+	defer client.Close()
+	accepted, err := lis.Accept()
+	assert.NoError(t, err)
+	accepted.Close()
+
+	// Only closing the listener fails the accepts
+	lis.Close()
+	_, err = lis.Accept()
+	assert.ErrorIs(t, err, net.ErrClosed)
+}
@@ -0,0 +1,101 @@
+package listener
+
+import (
+	"errors"
+	"net"
+	"sync"
+)
+
+// A listener accepting connections from several listeners, e.g. one per
+// address family, so that they share the same shaping.
+type multiListener struct {
+	listeners []net.Listener
+	conns     chan net.Conn
+	errs      chan error
+	done      chan struct{}
+	once      sync.Once
+}
+
+func Merge(listeners ...net.Listener) net.Listener {
+	if len(listeners) == 1 {
+		return listeners[0]
+	}
+	l := &multiListener{
+		listeners: listeners,
+		conns:     make(chan net.Conn),
+		errs:      make(chan error),
+		done:      make(chan struct{}),
+	}
+	for _, inner := range listeners {
+		go l.acceptLoop(inner)
+	}
+	return l
+}
+
+func (l *multiListener) acceptLoop(inner net.Listener) {
+	var backoff acceptBackoff
+	for {
+		conn, err := inner.Accept()
+		if err != nil {
+			select {
+			case l.errs <- err:
+			case <-l.done:
+				return
+			}
+			if errors.Is(err, net.ErrClosed) || !backoff.wait(l.done) {
+				return
+			}
+			continue
+		}
+		backoff.reset()
+		select {
+		case l.conns <- conn:
+		case <-l.done:
+			conn.Close()
+			return
+		}
+	}
+}
+
+func (l *multiListener) Accept() (net.Conn, error) {
+	select {
+	case conn := <-l.conns:
+		return conn, nil
+	case err := <-l.errs:
+		return nil, err
+	case <-l.done:
+		return nil, net.ErrClosed
+	}
+}
+
+func (l *multiListener) Close() error {
+	var err error
+	l.once.Do(func() {
+		close(l.done)
+		for _, inner := range l.listeners {
+			err = errors.Join(err, inner.Close())
+		}
+	})
+	return err
+}
+
+// The address of the first listener.
+func (l *multiListener) Addr() net.Addr {
+	return l.listeners[0].Addr()
+}
+
+// Listen on every address with the given network (tcp, tcp4 or tcp6).
+func ListenAll(network string, addresses []string) ([]net.Listener, error) {
+	listeners := make([]net.Listener, 0, len(addresses))
+	for _, address := range addresses {
+		lis, err := net.Listen(network, address)
+		if err != nil {
+			for _, l := range listeners {
+				l.Close()
+			}
+			return nil, err
+		}
+		listeners = append(listeners, lis)
+	}
+	return listeners, nil
+}
@@ -0,0 +1,77 @@
+package listener
+
+import (
+	"errors"
+	"math"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMerge(t *testing.T) {
+	listeners, err := ListenAll("tcp4", []string{"127.0.0.1:0", "127.0.0.1:0"})
+	assert.NoError(t, err)
+	lis := Merge(listeners...)
+	assert.Equal(t, listeners[0].Addr(), lis.Addr())
+
+	for _, inner := range listeners {
+		conn, err := net.Dial("tcp", inner.Addr().String())
+		assert.NoError(t, err)
+		defer conn.Close()
+	}
+	for range listeners {
+		conn, err := lis.Accept()
+		assert.NoError(t, err)
+		conn.Close()
+	}
+
+	assert.NoError(t, lis.Close())
+	_, err = lis.Accept()
+	assert.ErrorIs(t, err, net.ErrClosed)
+}
+
+func TestMergeSingle(t *testing.T) {
+	listeners, err := ListenAll("tcp", []string{"127.0.0.1:0"})
+	assert.NoError(t, err)
+	defer listeners[0].Close()
+	assert.Equal(t, listeners[0], Merge(listeners...))
+}
+
+func TestMergeBacksOffOnAcceptErrors(t *testing.T) {
+	listeners, err := ListenAll("tcp4", []string{"127.0.0.1:0", "127.0.0.1:0"})
+	assert.NoError(t, err)
+	exhausted := &exhaustedListener{Listener: listeners[0]}
+	exhausted.failures.Store(math.MaxInt32)
+	lis := Merge(exhausted, listeners[1])
+
+	conns := make(chan net.Conn)
+	go func() {
+		for {
+			conn, err := lis.Accept()
+			if errors.Is(err, net.ErrClosed) {
+				return
+			}
+			if err == nil {
+				conns <- conn
+			}
+		}
+	}()
+
+	// The errors are reported, but retried with a delay rather than in a loop
+	time.Sleep(100 * time.Millisecond)
+	assert.Less(t, exhausted.accepts.Load(), int32(10))
+
+	// The other listener keeps accepting meanwhile
+	client, err := net.Dial("tcp", listeners[1].Addr().String())
+	assert.NoError(t, err)
+	defer client.Close()
+	select {
+	case conn := <-conns:
+		conn.Close()
+	case <-time.After(time.Second):
+		t.Fatal("The connection was not accepted")
+	}
+	assert.NoError(t, lis.Close())
+}
@@ -0,0 +1,323 @@
+package listener
+
+import (
+	"context"
+	"net"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/rs/zerolog/log"
+)
+
+var (
+	connectionBytes = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "galoisd",
+		Subsystem: "listener",
+		Name:      "connection_bytes_total",
+		Help:      "Number of bytes read from and written to the tracked connections, by direction.",
+	}, []string{"direction"})
+	requestReceiveSeconds = promauto.NewHistogram(prometheus.HistogramOpts{
+		Namespace: "galoisd",
+		Subsystem: "listener",
+		Name:      "request_receive_seconds",
+		Help:      "Time a connection spent receiving requests of which the client sent the start.",
+		Buckets:   prometheus.ExponentialBuckets(0.001, 4, 10),
+	})
+	slowClients = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "galoisd",
+		Subsystem: "listener",
+		Name:      "slow_clients_total",
+		Help:      "Number of connections sending their requests slower than the minimum rate, by action taken.",
+	}, []string{"action"})
+)
+
+const (
+	actionLogged       = "logged"
+	actionDisconnected = "disconnected"
+)
+
+type SlowClientConfig struct {
+	// How long a request can be received below the minimum rate, zero
+	// disables the detection.
+	Timeout time.Duration
+	// Bytes per second a client must send a request at once it started it.
+	MinRate float64
+	// Close the connections of the slow clients instead of only logging them.
+	Disconnect bool
+}
+
+// Statistics of a connection, kept from its admission until it is closed.
+type ConnStats struct {
+	Remote       string
+	Since        time.Time
+	BytesRead    uint64
+	BytesWritten uint64
+	Requests     uint64
+	// Time spent receiving requests, while the connection slot is held
+	Stalled time.Duration
+	Slow    bool
+}
+
+// Tracks the connections accepted by the listeners it wraps, such that the
+// clients holding a slot while trickling their requests (slowloris) can be
+// detected. The server reports the requests with BeginRequest and EndRequest.
+type Tracker struct {
+	config SlowClientConfig
+	now    func() time.Time
+	mu     sync.Mutex
+	// Several connections can share an address, e.g. on unix sockets
+	conns map[string][]*TrackedConn
+}
+
+func NewTracker(config SlowClientConfig) *Tracker {
+	return &Tracker{
+		config: config,
+		now:    time.Now,
+		conns:  make(map[string][]*TrackedConn),
+	}
+}
+
+type trackedListener struct {
+	net.Listener
+	tracker *Tracker
+}
+
+// Wrap a listener, the connections it accepts being tracked until closed.
+func (t *Tracker) Wrap(inner net.Listener) net.Listener {
+	return &trackedListener{Listener: inner, tracker: t}
+}
+
+func (l *trackedListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	return l.tracker.track(conn), nil
+}
+
+func (t *Tracker) track(conn net.Conn) *TrackedConn {
+	c := &TrackedConn{
+		Conn:    conn,
+		tracker: t,
+		remote:  conn.RemoteAddr().String(),
+		since:   t.now(),
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.conns[c.remote] = append(t.conns[c.remote], c)
+	return c
+}
+
+func (t *Tracker) untrack(c *TrackedConn) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	conns := t.conns[c.remote]
+	for i, other := range conns {
+		if other == c {
+			conns = append(conns[:i], conns[i+1:]...)
+			break
+		}
+	}
+	if len(conns) == 0 {
+		delete(t.conns, c.remote)
+	} else {
+		t.conns[c.remote] = conns
+	}
+}
+
+// The connection of a remote address, nil if unknown or ambiguous.
+func (t *Tracker) Lookup(remote string) *TrackedConn {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	conns := t.conns[remote]
+	if len(conns) != 1 {
+		return nil
+	}
+	return conns[0]
+}
+
+func (t *Tracker) tracked() []*TrackedConn {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var conns []*TrackedConn
+	for _, remoteConns := range t.conns {
+		conns = append(conns, remoteConns...)
+	}
+	return conns
+}
+
+// Statistics of the open connections, oldest first.
+func (t *Tracker) Stats() []ConnStats {
+	var stats []ConnStats
+	for _, c := range t.tracked() {
+		stats = append(stats, c.Stats())
+	}
+	sort.Slice(stats, func(i, j int) bool { return stats[i].Since.Before(stats[j].Since) })
+	return stats
+}
+
+// Flag the connections receiving a request slower than the minimum rate for
+// longer than the timeout, closing them if configured to. Returns the
+// connections newly flagged.
+func (t *Tracker) check() []*TrackedConn {
+	if t.config.Timeout <= 0 {
+		return nil
+	}
+	now := t.now()
+	var flagged []*TrackedConn
+	for _, c := range t.tracked() {
+		if !c.flagSlow(now, t.config.Timeout, t.config.MinRate) {
+			continue
+		}
+		flagged = append(flagged, c)
+		stats := c.Stats()
+		event := log.Warn().
+			Str("remote", stats.Remote).
+			Uint64("bytes_read", stats.BytesRead).
+			Uint64("requests", stats.Requests).
+			Dur("stalled", stats.Stalled)
+		if t.config.Disconnect {
+			slowClients.WithLabelValues(actionDisconnected).Inc()
+			event.Msg("Disconnecting slow client")
+			c.Close()
+		} else {
+			slowClients.WithLabelValues(actionLogged).Inc()
+			event.Msg("Slow client holding a connection slot")
+		}
+	}
+	return flagged
+}
+
+// Check the connections every interval until the context is cancelled.
+func (t *Tracker) Watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			t.check()
+		}
+	}
+}
+
+type TrackedConn struct {
+	net.Conn
+	tracker      *Tracker
+	remote       string
+	since        time.Time
+	bytesRead    atomic.Uint64
+	bytesWritten atomic.Uint64
+
+	mu       sync.Mutex
+	requests uint64
+	// Requests started but not fully received yet
+	receiving int
+	// When the first of them started and the bytes read by then
+	receiveStart time.Time
+	receiveBytes uint64
+	stalled      time.Duration
+	slow         bool
+
+	once sync.Once
+}
+
+func (c *TrackedConn) Read(b []byte) (int, error) {
+	n, err := c.Conn.Read(b)
+	c.bytesRead.Add(uint64(n))
+	connectionBytes.WithLabelValues("read").Add(float64(n))
+	return n, err
+}
+
+func (c *TrackedConn) Write(b []byte) (int, error) {
+	n, err := c.Conn.Write(b)
+	c.bytesWritten.Add(uint64(n))
+	connectionBytes.WithLabelValues("written").Add(float64(n))
+	return n, err
+}
+
+// The client started sending a request.
+func (c *TrackedConn) BeginRequest() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.requests++
+	if c.receiving == 0 {
+		c.receiveStart = c.tracker.now()
+		c.receiveBytes = c.bytesRead.Load()
+	}
+	c.receiving++
+}
+
+// The request started by the client is fully received, or abandoned.
+func (c *TrackedConn) EndRequest() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.receiving == 0 {
+		return
+	}
+	c.receiving--
+	if c.receiving == 0 {
+		elapsed := c.tracker.now().Sub(c.receiveStart)
+		c.stalled += elapsed
+		requestReceiveSeconds.Observe(elapsed.Seconds())
+	}
+}
+
+func (c *TrackedConn) flagSlow(now time.Time, timeout time.Duration, minRate float64) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.slow || c.receiving == 0 {
+		return false
+	}
+	elapsed := now.Sub(c.receiveStart)
+	if elapsed < timeout {
+		return false
+	}
+	received := c.bytesRead.Load() - c.receiveBytes
+	if float64(received) >= minRate*elapsed.Seconds() {
+		return false
+	}
+	c.slow = true
+	return true
+}
+
+func (c *TrackedConn) Stats() ConnStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	stalled := c.stalled
+	if c.receiving > 0 {
+		stalled += c.tracker.now().Sub(c.receiveStart)
+	}
+	return ConnStats{
+		Remote:       c.remote,
+		Since:        c.since,
+		BytesRead:    c.bytesRead.Load(),
+		BytesWritten: c.bytesWritten.Load(),
+		Requests:     c.requests,
+		Stalled:      stalled,
+		Slow:         c.slow,
+	}
+}
+
+func (c *TrackedConn) Close() error {
+	err := c.Conn.Close()
+	c.once.Do(func() {
+		c.tracker.untrack(c)
+		stats := c.Stats()
+		log.Debug().
+			Str("remote", stats.Remote).
+			Dur("duration", c.tracker.now().Sub(stats.Since)).
+			Uint64("bytes_read", stats.BytesRead).
+			Uint64("bytes_written", stats.BytesWritten).
+			Uint64("requests", stats.Requests).
+			Dur("stalled", stats.Stalled).
+			Bool("slow", stats.Slow).
+			Msg("Connection closed")
+	})
+	return err
+}
@@ -0,0 +1,114 @@
+package listener
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestTracker(config SlowClientConfig, now *time.Time) *Tracker {
+	tracker := NewTracker(config)
+	tracker.now = func() time.Time { return *now }
+	return tracker
+}
+
+func TestTrackerStats(t *testing.T) {
+	now := time.Unix(0, 0)
+	tracker := newTestTracker(SlowClientConfig{}, &now)
+	client, server := net.Pipe()
+	defer client.Close()
+	conn := tracker.track(server)
+
+	go client.Write([]byte("hello"))
+	_, err := conn.Read(make([]byte, 5))
+	assert.NoError(t, err)
+	go client.Read(make([]byte, 3))
+	_, err = conn.Write([]byte("bye"))
+	assert.NoError(t, err)
+
+	conn.BeginRequest()
+	now = now.Add(2 * time.Second)
+	conn.EndRequest()
+	// Unbalanced ends are ignored
+	conn.EndRequest()
+	conn.BeginRequest()
+	now = now.Add(time.Second)
+
+	stats := tracker.Stats()
+	assert.Len(t, stats, 1)
+	assert.Equal(t, uint64(5), stats[0].BytesRead)
+	assert.Equal(t, uint64(3), stats[0].BytesWritten)
+	assert.Equal(t, uint64(2), stats[0].Requests)
+	assert.Equal(t, 3*time.Second, stats[0].Stalled)
+	assert.Same(t, conn, tracker.Lookup(conn.remote))
+
+	conn.Close()
+	assert.Empty(t, tracker.Stats())
+	assert.Nil(t, tracker.Lookup(conn.remote))
+}
+
+func TestTrackerLookupAmbiguous(t *testing.T) {
+	tracker := NewTracker(SlowClientConfig{})
+	_, first := net.Pipe()
+	_, second := net.Pipe()
+	a := tracker.track(first)
+	b := tracker.track(second)
+	assert.Nil(t, tracker.Lookup(a.remote))
+	b.Close()
+	assert.Same(t, a, tracker.Lookup(a.remote))
+	a.Close()
+}
+
+func TestTrackerSlowClient(t *testing.T) {
+	now := time.Unix(0, 0)
+	tracker := newTestTracker(SlowClientConfig{Timeout: 10 * time.Second, MinRate: 100}, &now)
+	_, server := net.Pipe()
+	conn := tracker.track(server)
+	defer conn.Close()
+
+	// Idle connections are not slow
+	now = now.Add(time.Minute)
+	assert.Empty(t, tracker.check())
+
+	conn.BeginRequest()
+	now = now.Add(5 * time.Second)
+	assert.Empty(t, tracker.check())
+	now = now.Add(5 * time.Second)
+	assert.Equal(t, []*TrackedConn{conn}, tracker.check())
+	// Flagged once
+	assert.Empty(t, tracker.check())
+	assert.True(t, conn.Stats().Slow)
+}
+
+func TestTrackerFastClient(t *testing.T) {
+	now := time.Unix(0, 0)
+	tracker := newTestTracker(SlowClientConfig{Timeout: time.Second, MinRate: 1}, &now)
+	client, server := net.Pipe()
+	defer client.Close()
+	conn := tracker.track(server)
+	defer conn.Close()
+
+	conn.BeginRequest()
+	go client.Write(make([]byte, 10))
+	_, err := conn.Read(make([]byte, 10))
+	assert.NoError(t, err)
+	now = now.Add(5 * time.Second)
+	assert.Empty(t, tracker.check())
+}
+
+func TestTrackerDisconnect(t *testing.T) {
+	now := time.Unix(0, 0)
+	tracker := newTestTracker(SlowClientConfig{Timeout: time.Second, MinRate: 1, Disconnect: true}, &now)
+	client, server := net.Pipe()
+	defer client.Close()
+	conn := tracker.track(server)
+
+	conn.BeginRequest()
+	now = now.Add(2 * time.Second)
+	assert.Len(t, tracker.check(), 1)
+	assert.Empty(t, tracker.Stats())
+	_, err := client.Read(make([]byte, 1))
+	assert.Error(t, err)
+}
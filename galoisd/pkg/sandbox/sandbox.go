@@ -0,0 +1,24 @@
+// Package sandbox confines the prover once it no longer needs its privileges:
+// the keys are loaded and the listeners bound. The process is switched to an
+// unprivileged user, its filesystem access is restricted to a few directories
+// with Landlock and the syscalls it never needs are denied with seccomp.
+package sandbox
+
+import "errors"
+
+var ErrUnsupported = errors.New("sandboxing is not supported on this platform")
+
+type Config struct {
+	// Identity the process is switched to, only applied if set.
+	UID *int
+	GID *int
+	// Paths that can still be read and written, typically the data dir.
+	ReadWrite []string
+	// Paths that can still be read, e.g. the TLS certificate.
+	ReadOnly []string
+}
+
+// Apply the sandbox to every thread of the process. It can't be undone.
+func Apply(cfg Config) error {
+	return apply(cfg)
+}
@@ -0,0 +1,237 @@
+//go:build linux
+
+package sandbox
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+func apply(cfg Config) error {
+	if err := dropPrivileges(cfg); err != nil {
+		return err
+	}
+	// Required by both Landlock and seccomp when unprivileged.
+	if _, _, errno := syscall.AllThreadsSyscall(unix.SYS_PRCTL, unix.PR_SET_NO_NEW_PRIVS, 1, 0); errno != 0 {
+		return allThreadsError("set no_new_privs", errno)
+	}
+	if err := restrictFilesystem(cfg); err != nil {
+		return err
+	}
+	return restrictSyscalls()
+}
+
+// syscall.AllThreadsSyscall is only available when the runtime owns all the
+// threads, which isn't the case once cgo is linked in.
+func allThreadsError(op string, errno syscall.Errno) error {
+	if errno == syscall.ENOTSUP {
+		return fmt.Errorf("Could not %s on all threads, the binary must be built with CGO_ENABLED=0", op)
+	}
+	return fmt.Errorf("Could not %s %s", op, errno)
+}
+
+// The standard library applies these to all the threads, with or without cgo.
+func dropPrivileges(cfg Config) error {
+	if cfg.GID != nil {
+		if err := syscall.Setgroups([]int{*cfg.GID}); err != nil {
+			return fmt.Errorf("Could not set groups %s", err)
+		}
+		if err := syscall.Setgid(*cfg.GID); err != nil {
+			return fmt.Errorf("Could not set gid %s", err)
+		}
+	}
+	if cfg.UID != nil {
+		if err := syscall.Setuid(*cfg.UID); err != nil {
+			return fmt.Errorf("Could not set uid %s", err)
+		}
+	}
+	return nil
+}
+
+const (
+	accessFile = unix.LANDLOCK_ACCESS_FS_EXECUTE |
+		unix.LANDLOCK_ACCESS_FS_WRITE_FILE |
+		unix.LANDLOCK_ACCESS_FS_READ_FILE |
+		unix.LANDLOCK_ACCESS_FS_TRUNCATE |
+		unix.LANDLOCK_ACCESS_FS_IOCTL_DEV
+	accessRead = unix.LANDLOCK_ACCESS_FS_READ_FILE |
+		unix.LANDLOCK_ACCESS_FS_READ_DIR
+)
+
+// Filesystem rights known by the kernel, from the Landlock ABI version.
+func handledAccess() (uint64, error) {
+	abi, _, errno := unix.Syscall(unix.SYS_LANDLOCK_CREATE_RULESET, 0, 0, unix.LANDLOCK_CREATE_RULESET_VERSION)
+	if errno != 0 {
+		return 0, fmt.Errorf("Could not query Landlock, is it enabled in the kernel? %s", errno)
+	}
+	// All the rights of the first version.
+	access := uint64(unix.LANDLOCK_ACCESS_FS_REFER - 1)
+	if abi >= 2 {
+		access |= unix.LANDLOCK_ACCESS_FS_REFER
+	}
+	if abi >= 3 {
+		access |= unix.LANDLOCK_ACCESS_FS_TRUNCATE
+	}
+	if abi >= 5 {
+		access |= unix.LANDLOCK_ACCESS_FS_IOCTL_DEV
+	}
+	return access, nil
+}
+
+func addPathRule(ruleset int, path string, access uint64) error {
+	fd, err := unix.Open(path, unix.O_PATH|unix.O_CLOEXEC, 0)
+	if err != nil {
+		return fmt.Errorf("Could not open %s %s", path, err)
+	}
+	defer unix.Close(fd)
+	info, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("Could not stat %s %s", path, err)
+	}
+	// Directory rights are rejected on files.
+	if !info.IsDir() {
+		access &= accessFile
+	}
+	attr := unix.LandlockPathBeneathAttr{
+		Allowed_access: access,
+		Parent_fd:      int32(fd),
+	}
+	_, _, errno := unix.Syscall6(unix.SYS_LANDLOCK_ADD_RULE, uintptr(ruleset), unix.LANDLOCK_RULE_PATH_BENEATH, uintptr(unsafe.Pointer(&attr)), 0, 0, 0)
+	if errno != 0 {
+		return fmt.Errorf("Could not allow %s %s", path, errno)
+	}
+	return nil
+}
+
+func restrictFilesystem(cfg Config) error {
+	handled, err := handledAccess()
+	if err != nil {
+		return err
+	}
+	attr := unix.LandlockRulesetAttr{
+		Access_fs: handled,
+	}
+	ruleset, _, errno := unix.Syscall(unix.SYS_LANDLOCK_CREATE_RULESET, uintptr(unsafe.Pointer(&attr)), unsafe.Sizeof(attr), 0)
+	if errno != 0 {
+		return fmt.Errorf("Could not create Landlock ruleset %s", errno)
+	}
+	defer unix.Close(int(ruleset))
+	for _, path := range cfg.ReadWrite {
+		if err := addPathRule(int(ruleset), path, handled&^unix.LANDLOCK_ACCESS_FS_EXECUTE); err != nil {
+			return err
+		}
+	}
+	for _, path := range cfg.ReadOnly {
+		if err := addPathRule(int(ruleset), path, handled&accessRead); err != nil {
+			return err
+		}
+	}
+	if _, _, errno := syscall.AllThreadsSyscall(unix.SYS_LANDLOCK_RESTRICT_SELF, ruleset, 0, 0); errno != 0 {
+		return allThreadsError("restrict the filesystem", errno)
+	}
+	return nil
+}
+
+// Syscalls the prover never makes, denied to limit what an exploit can do.
+var deniedSyscalls = []uint32{
+	unix.SYS_EXECVE,
+	unix.SYS_EXECVEAT,
+	unix.SYS_PTRACE,
+	unix.SYS_PROCESS_VM_READV,
+	unix.SYS_PROCESS_VM_WRITEV,
+	unix.SYS_MOUNT,
+	unix.SYS_UMOUNT2,
+	unix.SYS_PIVOT_ROOT,
+	unix.SYS_CHROOT,
+	unix.SYS_UNSHARE,
+	unix.SYS_SETNS,
+	unix.SYS_KEXEC_LOAD,
+	unix.SYS_INIT_MODULE,
+	unix.SYS_FINIT_MODULE,
+	unix.SYS_DELETE_MODULE,
+	unix.SYS_REBOOT,
+	unix.SYS_SWAPON,
+	unix.SYS_SWAPOFF,
+	unix.SYS_BPF,
+	unix.SYS_PERF_EVENT_OPEN,
+	unix.SYS_USERFAULTFD,
+	unix.SYS_KEYCTL,
+	unix.SYS_ADD_KEY,
+	unix.SYS_REQUEST_KEY,
+	unix.SYS_SETUID,
+	unix.SYS_SETGID,
+	unix.SYS_SETREUID,
+	unix.SYS_SETREGID,
+	unix.SYS_SETRESUID,
+	unix.SYS_SETRESGID,
+	unix.SYS_SETGROUPS,
+}
+
+func auditArch() (uint32, error) {
+	switch runtime.GOARCH {
+	case "amd64":
+		return unix.AUDIT_ARCH_X86_64, nil
+	case "arm64":
+		return unix.AUDIT_ARCH_AARCH64, nil
+	default:
+		return 0, fmt.Errorf("Could not filter syscalls on %s %s", runtime.GOARCH, ErrUnsupported)
+	}
+}
+
+func stmt(code uint16, k uint32) unix.SockFilter {
+	return unix.SockFilter{Code: code, K: k}
+}
+
+func jump(code uint16, k uint32, jt, jf uint8) unix.SockFilter {
+	return unix.SockFilter{Code: code, Jt: jt, Jf: jf, K: k}
+}
+
+func restrictSyscalls() error {
+	arch, err := auditArch()
+	if err != nil {
+		return err
+	}
+	const (
+		offsetNr   = 0
+		offsetArch = 4
+		// The x32 ABI shares the x86_64 audit arch with this bit set.
+		x32SyscallBit = 0x40000000
+	)
+	deny := uint32(unix.SECCOMP_RET_ERRNO | uint32(unix.EPERM))
+	filter := []unix.SockFilter{
+		stmt(unix.BPF_LD|unix.BPF_W|unix.BPF_ABS, offsetArch),
+		jump(unix.BPF_JMP|unix.BPF_JEQ|unix.BPF_K, arch, 1, 0),
+		stmt(unix.BPF_RET|unix.BPF_K, unix.SECCOMP_RET_KILL_PROCESS),
+		stmt(unix.BPF_LD|unix.BPF_W|unix.BPF_ABS, offsetNr),
+		jump(unix.BPF_JMP|unix.BPF_JGE|unix.BPF_K, x32SyscallBit, 0, 1),
+		stmt(unix.BPF_RET|unix.BPF_K, deny),
+	}
+	for _, nr := range deniedSyscalls {
+		filter = append(
+			filter,
+			jump(unix.BPF_JMP|unix.BPF_JEQ|unix.BPF_K, nr, 0, 1),
+			stmt(unix.BPF_RET|unix.BPF_K, deny),
+		)
+	}
+	filter = append(filter, stmt(unix.BPF_RET|unix.BPF_K, unix.SECCOMP_RET_ALLOW))
+	prog := unix.SockFprog{
+		Len:    uint16(len(filter)),
+		Filter: &filter[0],
+	}
+	// Unlike Landlock, seccomp can synchronize the filter across threads itself.
+	// It returns the id of the thread that couldn't be synchronized, if any.
+	tid, _, errno := unix.Syscall(unix.SYS_SECCOMP, unix.SECCOMP_SET_MODE_FILTER, unix.SECCOMP_FILTER_FLAG_TSYNC, uintptr(unsafe.Pointer(&prog)))
+	runtime.KeepAlive(filter)
+	if errno != 0 {
+		return fmt.Errorf("Could not filter syscalls %s", errno)
+	}
+	if tid != 0 {
+		return fmt.Errorf("Could not filter syscalls of thread %d", tid)
+	}
+	return nil
+}
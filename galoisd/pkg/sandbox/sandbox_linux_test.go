@@ -0,0 +1,81 @@
+//go:build linux
+
+package sandbox
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"testing"
+)
+
+const envSandboxedDir = "GALOISD_TEST_SANDBOXED_DIR"
+
+// The sandbox can't be lifted, it is applied in a child process running this test.
+func TestApply(t *testing.T) {
+	if dir := os.Getenv(envSandboxedDir); dir != "" {
+		runSandboxed(t, dir)
+		return
+	}
+	dir := t.TempDir()
+	if err := os.Mkdir(filepath.Join(dir, "data"), 0700); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "cert.pem"), []byte("cert"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	cmd := exec.Command(os.Args[0], "-test.run=^TestApply$", "-test.v")
+	cmd.Env = append(os.Environ(), envSandboxedDir+"="+dir)
+	out, err := cmd.CombinedOutput()
+	if strings.Contains(string(out), "SKIP") {
+		t.Skip(string(out))
+	}
+	if err != nil {
+		t.Fatalf("%s\n%s", err, out)
+	}
+}
+
+func runSandboxed(t *testing.T, root string) {
+	executable, err := os.Executable()
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := filepath.Join(root, "data")
+	cert := filepath.Join(root, "cert.pem")
+	err = Apply(Config{
+		ReadWrite: []string{dir},
+		ReadOnly:  []string{cert},
+	})
+	if err != nil && strings.Contains(err.Error(), "is it enabled in the kernel") {
+		t.Skip(err)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	path := filepath.Join(dir, "proof.bin")
+	if err := os.WriteFile(path, []byte{1, 2, 3}, 0600); err != nil {
+		t.Fatalf("data dir must be writable: %s", err)
+	}
+	if _, err := os.ReadFile(path); err != nil {
+		t.Fatalf("data dir must be readable: %s", err)
+	}
+	if _, err := os.ReadFile(cert); err != nil {
+		t.Fatalf("read only path must be readable: %s", err)
+	}
+	if err := os.WriteFile(cert, nil, 0); !errors.Is(err, syscall.EACCES) {
+		t.Fatalf("read only path must not be writable: %v", err)
+	}
+	if _, err := os.ReadDir(root); !errors.Is(err, syscall.EACCES) {
+		t.Fatalf("parent of the data dir must not be readable: %v", err)
+	}
+	if err := exec.Command(executable, "-test.run=^$").Run(); !errors.Is(err, syscall.EACCES) && !errors.Is(err, syscall.EPERM) {
+		t.Fatalf("exec must be denied: %v", err)
+	}
+	if err := syscall.Setuid(0); !errors.Is(err, syscall.EPERM) {
+		t.Fatalf("setuid must be denied: %v", err)
+	}
+}
@@ -0,0 +1,7 @@
+//go:build !linux
+
+package sandbox
+
+func apply(cfg Config) error {
+	return ErrUnsupported
+}
@@ -0,0 +1,175 @@
+package storage
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+func init() {
+	Register("file", func(uri *url.URL) (Backend, error) {
+		root := uri.Path
+		if uri.Host != "" {
+			// file://relative/path
+			root = filepath.Join(uri.Host, uri.Path)
+		}
+		return NewFilesystem(root), nil
+	})
+}
+
+type filesystem struct {
+	root string
+}
+
+// NewFilesystem stores objects as files below root. An empty root resolves keys
+// relative to the working directory.
+func NewFilesystem(root string) Backend {
+	return &filesystem{root: root}
+}
+
+func (f *filesystem) path(key string) string {
+	return filepath.Join(f.root, filepath.FromSlash(key))
+}
+
+// LocalPath returns the file an object is stored in, if the backend is a
+// filesystem.
+func LocalPath(backend Backend, key string) (string, bool) {
+	f, ok := backend.(*filesystem)
+	if !ok {
+		return "", false
+	}
+	return f.path(key), true
+}
+
+func (f *filesystem) Reader(ctx context.Context, key string) (io.ReadCloser, error) {
+	file, err := os.Open(f.path(key))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return file, nil
+}
+
+// Infix of the temporary files the objects are written to before being renamed.
+const tempInfix = ".tmp-"
+
+func isTemp(name string) bool {
+	return strings.HasPrefix(name, ".") && strings.Contains(name, tempInfix)
+}
+
+// Writes to a temporary file in the same directory, renamed over the object
+// once synced, such that a crash or a full disk never leaves a partially
+// written object behind.
+type fileWriter struct {
+	*bufio.Writer
+	file *os.File
+	path string
+}
+
+func (w *fileWriter) Close() error {
+	if err := w.commit(); err != nil {
+		w.Abort()
+		return err
+	}
+	// Persist the rename itself
+	dir, err := os.Open(filepath.Dir(w.path))
+	if err != nil {
+		return err
+	}
+	defer dir.Close()
+	return dir.Sync()
+}
+
+func (w *fileWriter) commit() error {
+	if err := w.Writer.Flush(); err != nil {
+		return err
+	}
+	if err := w.file.Chmod(0644); err != nil {
+		return err
+	}
+	if err := w.file.Sync(); err != nil {
+		return err
+	}
+	if err := w.file.Close(); err != nil {
+		return err
+	}
+	return os.Rename(w.file.Name(), w.path)
+}
+
+func (w *fileWriter) Abort() error {
+	w.file.Close()
+	err := os.Remove(w.file.Name())
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+func (f *filesystem) Writer(ctx context.Context, key string) (io.WriteCloser, error) {
+	path := f.path(key)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, err
+	}
+	file, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+tempInfix+"*")
+	if err != nil {
+		return nil, err
+	}
+	return &fileWriter{Writer: bufio.NewWriter(file), file: file, path: path}, nil
+}
+
+func (f *filesystem) Exists(ctx context.Context, key string) (bool, error) {
+	_, err := os.Stat(f.path(key))
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (f *filesystem) Delete(ctx context.Context, key string) error {
+	err := os.Remove(f.path(key))
+	if errors.Is(err, fs.ErrNotExist) {
+		return ErrNotFound
+	}
+	return err
+}
+
+func (f *filesystem) List(ctx context.Context, prefix string) ([]string, error) {
+	// Walk the deepest directory fully contained in the prefix
+	dir := prefix
+	if !strings.HasSuffix(dir, "/") {
+		dir = filepath.ToSlash(filepath.Dir(dir))
+	}
+	var keys []string
+	root := f.path(dir)
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if d.IsDir() || isTemp(d.Name()) {
+			return nil
+		}
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		key := filepath.ToSlash(filepath.Join(dir, rel))
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+		return nil
+	})
+	sort.Strings(keys)
+	return keys, err
+}
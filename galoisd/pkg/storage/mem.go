@@ -0,0 +1,89 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+)
+
+func init() {
+	Register("mem", func(uri *url.URL) (Backend, error) {
+		return NewMemory(), nil
+	})
+}
+
+type memory struct {
+	mu      sync.RWMutex
+	objects map[string][]byte
+}
+
+// NewMemory stores objects in memory, mostly useful for tests and ephemeral
+// deployments.
+func NewMemory() Backend {
+	return &memory{objects: map[string][]byte{}}
+}
+
+func (m *memory) Reader(ctx context.Context, key string) (io.ReadCloser, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	object, found := m.objects[key]
+	if !found {
+		return nil, ErrNotFound
+	}
+	return io.NopCloser(bytes.NewReader(object)), nil
+}
+
+type memoryWriter struct {
+	bytes.Buffer
+	memory *memory
+	key    string
+}
+
+func (w *memoryWriter) Close() error {
+	w.memory.mu.Lock()
+	defer w.memory.mu.Unlock()
+	w.memory.objects[w.key] = w.Bytes()
+	return nil
+}
+
+func (w *memoryWriter) Abort() error {
+	return nil
+}
+
+func (m *memory) Writer(ctx context.Context, key string) (io.WriteCloser, error) {
+	return &memoryWriter{memory: m, key: key}, nil
+}
+
+func (m *memory) Exists(ctx context.Context, key string) (bool, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	_, found := m.objects[key]
+	return found, nil
+}
+
+func (m *memory) Delete(ctx context.Context, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, found := m.objects[key]; !found {
+		return ErrNotFound
+	}
+	delete(m.objects, key)
+	return nil
+}
+
+func (m *memory) List(ctx context.Context, prefix string) ([]string, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var keys []string
+	for key := range m.objects {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
@@ -0,0 +1,154 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"sort"
+	"sync"
+)
+
+var (
+	ErrNotFound = errors.New("not found")
+	// The object ends before or after the end of the value it encodes, e.g.
+	// because it was partially written or concatenated with another one.
+	ErrTruncated    = errors.New("truncated object, it may have been partially written")
+	ErrTrailingData = errors.New("unexpected data after the end of the object")
+)
+
+// Backend stores the artifacts of the prover (circuit, keys, proofs, snapshots).
+// Keys are slash separated paths, relative to the root of the backend.
+type Backend interface {
+	// Open the object for reading, returning ErrNotFound if it doesn't exist.
+	Reader(ctx context.Context, key string) (io.ReadCloser, error)
+	// Create or replace the object. The object must only become visible once
+	// the writer has been successfully closed. Writers should implement Aborter.
+	Writer(ctx context.Context, key string) (io.WriteCloser, error)
+	Exists(ctx context.Context, key string) (bool, error)
+	Delete(ctx context.Context, key string) error
+	// List the keys starting with prefix, sorted.
+	List(ctx context.Context, prefix string) ([]string, error)
+}
+
+// Aborter is implemented by the writers able to discard what has been written,
+// leaving the previous object, if any, untouched.
+type Aborter interface {
+	Abort() error
+}
+
+// Abort a failed write. Writers that can't abort are closed.
+func Abort(w io.WriteCloser) error {
+	if a, ok := w.(Aborter); ok {
+		return a.Abort()
+	}
+	return w.Close()
+}
+
+// Factory instantiates a backend from its URI.
+type Factory func(uri *url.URL) (Backend, error)
+
+var (
+	factoriesMu sync.RWMutex
+	factories   = map[string]Factory{}
+)
+
+// Register makes a backend available for the given URI scheme. Downstream
+// deployments can register their own backends (S3, Ceph, IPFS...) before the
+// serve command is executed.
+func Register(scheme string, factory Factory) {
+	factoriesMu.Lock()
+	defer factoriesMu.Unlock()
+	if _, found := factories[scheme]; found {
+		panic(fmt.Sprintf("storage backend %s registered twice", scheme))
+	}
+	factories[scheme] = factory
+}
+
+// Schemes returns the registered URI schemes.
+func Schemes() []string {
+	factoriesMu.RLock()
+	defer factoriesMu.RUnlock()
+	schemes := make([]string, 0, len(factories))
+	for scheme := range factories {
+		schemes = append(schemes, scheme)
+	}
+	sort.Strings(schemes)
+	return schemes
+}
+
+// Open instantiates the backend matching the scheme of the URI. A URI without
+// scheme is a filesystem path.
+func Open(uri string) (Backend, error) {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return nil, fmt.Errorf("Could not parse storage uri %s", err)
+	}
+	scheme := u.Scheme
+	if scheme == "" {
+		scheme = "file"
+		u = &url.URL{Scheme: scheme, Path: uri}
+	}
+	factoriesMu.RLock()
+	factory, found := factories[scheme]
+	factoriesMu.RUnlock()
+	if !found {
+		return nil, fmt.Errorf("Unknown storage scheme %s, available: %v", scheme, Schemes())
+	}
+	return factory(u)
+}
+
+func ReadFrom(ctx context.Context, backend Backend, key string, obj io.ReaderFrom) (int64, error) {
+	r, err := backend.Reader(ctx, key)
+	if err != nil {
+		return 0, err
+	}
+	defer r.Close()
+	read, err := obj.ReadFrom(r)
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return read, fmt.Errorf("%w: %s", ErrTruncated, err)
+	}
+	if err != nil {
+		return read, err
+	}
+	var b [1]byte
+	if n, _ := r.Read(b[:]); n > 0 {
+		return read, ErrTrailingData
+	}
+	return read, nil
+}
+
+func SaveTo(ctx context.Context, backend Backend, key string, x io.WriterTo) (int64, error) {
+	w, err := backend.Writer(ctx, key)
+	if err != nil {
+		return 0, err
+	}
+	written, err := x.WriteTo(w)
+	if err != nil {
+		Abort(w)
+		return written, err
+	}
+	return written, w.Close()
+}
+
+func ReadAll(ctx context.Context, backend Backend, key string) ([]byte, error) {
+	r, err := backend.Reader(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return io.ReadAll(r)
+}
+
+func WriteAll(ctx context.Context, backend Backend, key string, bz []byte) error {
+	w, err := backend.Writer(ctx, key)
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(bz); err != nil {
+		Abort(w)
+		return err
+	}
+	return w.Close()
+}
@@ -0,0 +1,182 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testBackend(t *testing.T, backend Backend) {
+	ctx := context.Background()
+
+	_, err := backend.Reader(ctx, "proofs/missing.json")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	exists, err := backend.Exists(ctx, "proofs/a.json")
+	assert.NoError(t, err)
+	assert.False(t, exists)
+
+	assert.NoError(t, WriteAll(ctx, backend, "proofs/a.json", []byte("a")))
+	assert.NoError(t, WriteAll(ctx, backend, "proofs/b.json", []byte("b")))
+	assert.NoError(t, WriteAll(ctx, backend, "vk.bin", []byte("vk")))
+
+	exists, err = backend.Exists(ctx, "proofs/a.json")
+	assert.NoError(t, err)
+	assert.True(t, exists)
+
+	bz, err := ReadAll(ctx, backend, "proofs/b.json")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("b"), bz)
+
+	keys, err := backend.List(ctx, "proofs/")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"proofs/a.json", "proofs/b.json"}, keys)
+
+	keys, err = backend.List(ctx, "proofs/a")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"proofs/a.json"}, keys)
+
+	assert.NoError(t, backend.Delete(ctx, "proofs/a.json"))
+	assert.ErrorIs(t, backend.Delete(ctx, "proofs/a.json"), ErrNotFound)
+}
+
+func TestFilesystem(t *testing.T) {
+	testBackend(t, NewFilesystem(t.TempDir()))
+}
+
+func TestMemory(t *testing.T) {
+	testBackend(t, NewMemory())
+}
+
+func TestOpen(t *testing.T) {
+	dir := t.TempDir()
+	for _, uri := range []string{dir, "file://" + dir} {
+		backend, err := Open(uri)
+		assert.NoError(t, err)
+		assert.Equal(t, dir, backend.(*filesystem).root)
+	}
+
+	backend, err := Open("mem://")
+	assert.NoError(t, err)
+	assert.IsType(t, &memory{}, backend)
+
+	_, err = Open("unknown://bucket")
+	assert.Error(t, err)
+}
+
+// Length prefixed bytes, like the artifacts.
+type blob struct {
+	data []byte
+}
+
+func (b *blob) WriteTo(w io.Writer) (int64, error) {
+	if err := binary.Write(w, binary.BigEndian, uint32(len(b.data))); err != nil {
+		return 0, err
+	}
+	n, err := w.Write(b.data)
+	return int64(4 + n), err
+}
+
+func (b *blob) ReadFrom(r io.Reader) (int64, error) {
+	var size uint32
+	if err := binary.Read(r, binary.BigEndian, &size); err != nil {
+		return 0, err
+	}
+	b.data = make([]byte, size)
+	n, err := io.ReadFull(r, b.data)
+	return int64(4 + n), err
+}
+
+type failingWriterTo struct{}
+
+func (failingWriterTo) WriteTo(w io.Writer) (int64, error) {
+	n, _ := w.Write([]byte("partial"))
+	return int64(n), errors.New("no space left on device")
+}
+
+func TestFailedWriteKeepsPreviousObject(t *testing.T) {
+	ctx := context.Background()
+	root := t.TempDir()
+	backend := NewFilesystem(root)
+	assert.NoError(t, WriteAll(ctx, backend, "pk.bin", []byte("previous")))
+
+	_, err := SaveTo(ctx, backend, "pk.bin", failingWriterTo{})
+	assert.Error(t, err)
+	bz, err := ReadAll(ctx, backend, "pk.bin")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("previous"), bz)
+
+	// The temporary file is removed
+	entries, err := os.ReadDir(root)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
+
+func TestTemporaryFilesAreNotListed(t *testing.T) {
+	ctx := context.Background()
+	root := t.TempDir()
+	backend := NewFilesystem(root)
+	w, err := backend.Writer(ctx, "proofs/a.json")
+	assert.NoError(t, err)
+	_, err = w.Write([]byte("a"))
+	assert.NoError(t, err)
+
+	keys, err := backend.List(ctx, "proofs/")
+	assert.NoError(t, err)
+	assert.Empty(t, keys)
+	exists, err := backend.Exists(ctx, "proofs/a.json")
+	assert.NoError(t, err)
+	assert.False(t, exists)
+
+	assert.NoError(t, w.Close())
+	keys, err = backend.List(ctx, "proofs/")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"proofs/a.json"}, keys)
+	info, err := os.Stat(filepath.Join(root, "proofs", "a.json"))
+	assert.NoError(t, err)
+	assert.Equal(t, os.FileMode(0644), info.Mode().Perm())
+}
+
+func TestReadFromDetectsPartialObjects(t *testing.T) {
+	ctx := context.Background()
+	backend := NewMemory()
+	var buf bytes.Buffer
+	_, err := (&blob{data: []byte("proving key")}).WriteTo(&buf)
+	assert.NoError(t, err)
+	full := buf.Bytes()
+
+	assert.NoError(t, WriteAll(ctx, backend, "pk.bin", full))
+	var b blob
+	read, err := ReadFrom(ctx, backend, "pk.bin", &b)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(len(full)), read)
+	assert.Equal(t, []byte("proving key"), b.data)
+
+	assert.NoError(t, WriteAll(ctx, backend, "pk.bin", full[:len(full)-3]))
+	_, err = ReadFrom(ctx, backend, "pk.bin", &blob{})
+	assert.ErrorIs(t, err, ErrTruncated)
+
+	assert.NoError(t, WriteAll(ctx, backend, "pk.bin", full[:2]))
+	_, err = ReadFrom(ctx, backend, "pk.bin", &blob{})
+	assert.ErrorIs(t, err, ErrTruncated)
+
+	assert.NoError(t, WriteAll(ctx, backend, "pk.bin", append(full, 0)))
+	_, err = ReadFrom(ctx, backend, "pk.bin", &blob{})
+	assert.ErrorIs(t, err, ErrTrailingData)
+}
+
+func TestLocalPath(t *testing.T) {
+	path, ok := LocalPath(NewFilesystem("/var/lib/galoisd"), "keys/pk.bin")
+	assert.True(t, ok)
+	assert.Equal(t, "/var/lib/galoisd/keys/pk.bin", path)
+
+	_, ok = LocalPath(NewMemory(), "keys/pk.bin")
+	assert.False(t, ok)
+}
@@ -0,0 +1,116 @@
+// Package tlsreload serves a TLS certificate that is reloaded from disk as soon
+// as it changes, so that rotating it doesn't require a restart of the daemon.
+package tlsreload
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+type Reloader struct {
+	certPath string
+	keyPath  string
+
+	mu          sync.RWMutex
+	cert        *tls.Certificate
+	certModTime time.Time
+	keyModTime  time.Time
+}
+
+func New(certPath, keyPath string) (*Reloader, error) {
+	r := &Reloader{
+		certPath: certPath,
+		keyPath:  keyPath,
+	}
+	if _, err := r.Reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func modTime(path string) (time.Time, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return info.ModTime(), nil
+}
+
+// Reload the certificate if either the certificate or the key file changed,
+// returning whether a new certificate has been loaded. The current
+// certificate is kept if the new one is invalid, e.g. only one of the files
+// has been written yet.
+func (r *Reloader) Reload() (bool, error) {
+	certModTime, err := modTime(r.certPath)
+	if err != nil {
+		return false, err
+	}
+	keyModTime, err := modTime(r.keyPath)
+	if err != nil {
+		return false, err
+	}
+	r.mu.RLock()
+	unchanged := r.cert != nil && certModTime.Equal(r.certModTime) && keyModTime.Equal(r.keyModTime)
+	r.mu.RUnlock()
+	if unchanged {
+		return false, nil
+	}
+	cert, err := tls.LoadX509KeyPair(r.certPath, r.keyPath)
+	if err != nil {
+		return false, fmt.Errorf("Could not load TLS certificate %s", err)
+	}
+	r.mu.Lock()
+	r.cert = &cert
+	r.certModTime = certModTime
+	r.keyModTime = keyModTime
+	r.mu.Unlock()
+	return true, nil
+}
+
+// Periodically reload the certificate until the context is done.
+func (r *Reloader) Watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.ReloadAndLog()
+		}
+	}
+}
+
+func (r *Reloader) ReloadAndLog() {
+	reloaded, err := r.Reload()
+	if err != nil {
+		log.Warn().Str("cert", r.certPath).Err(err).Msg("Could not reload TLS certificate, keeping the current one")
+	} else if reloaded {
+		log.Info().Str("cert", r.certPath).Msg("TLS certificate reloaded")
+	}
+}
+
+// Certificate currently served.
+func (r *Reloader) Certificate() *tls.Certificate {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.cert
+}
+
+func (r *Reloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	return r.Certificate(), nil
+}
+
+// TLS configuration serving the reloaded certificate.
+func (r *Reloader) Config() *tls.Config {
+	return &tls.Config{
+		MinVersion:     tls.VersionTLS12,
+		GetCertificate: r.GetCertificate,
+	}
+}
@@ -0,0 +1,73 @@
+package tlsreload
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func writeCertificate(t *testing.T, certPath, keyPath string, serial int64, modTime time.Time) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: "galoisd"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	assert.NoError(t, err)
+	assert.NoError(t, os.WriteFile(certPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	assert.NoError(t, os.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600))
+	assert.NoError(t, os.Chtimes(certPath, modTime, modTime))
+	assert.NoError(t, os.Chtimes(keyPath, modTime, modTime))
+}
+
+func serial(t *testing.T, r *Reloader) int64 {
+	cert, err := x509.ParseCertificate(r.Certificate().Certificate[0])
+	assert.NoError(t, err)
+	return cert.SerialNumber.Int64()
+}
+
+func TestReload(t *testing.T) {
+	dir := t.TempDir()
+	certPath := filepath.Join(dir, "cert.pem")
+	keyPath := filepath.Join(dir, "key.pem")
+	now := time.Now()
+	writeCertificate(t, certPath, keyPath, 1, now)
+
+	r, err := New(certPath, keyPath)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), serial(t, r))
+
+	reloaded, err := r.Reload()
+	assert.NoError(t, err)
+	assert.False(t, reloaded)
+
+	writeCertificate(t, certPath, keyPath, 2, now.Add(time.Second))
+	reloaded, err = r.Reload()
+	assert.NoError(t, err)
+	assert.True(t, reloaded)
+	assert.Equal(t, int64(2), serial(t, r))
+
+	// A half written rotation keeps serving the current certificate
+	assert.NoError(t, os.WriteFile(keyPath, []byte("garbage"), 0600))
+	_, err = r.Reload()
+	assert.Error(t, err)
+	cert, err := r.GetCertificate(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, r.Certificate(), cert)
+	assert.Equal(t, int64(2), serial(t, r))
+}
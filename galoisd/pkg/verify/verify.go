@@ -0,0 +1,221 @@
+// Package verify checks the proofs produced by galoisd. It depends on
+// gnark-crypto and, for the hash to field of the commitments, the crypto/bn254
+// package of cometbls, and is free of cgo so that relayers and indexers can
+// verify proofs without pulling the prover and the gnark proving stack.
+package verify
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+
+	cometbn254 "github.com/cometbft/cometbft/crypto/bn254"
+	"github.com/consensys/gnark-crypto/ecc"
+	curve "github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr/pedersen"
+)
+
+var (
+	ErrInvalidProof    = errors.New("pairing doesn't match")
+	ErrInvalidSubgroup = errors.New("points in the proof are not in the correct subgroup")
+)
+
+// Groth16 verifying key, as serialized by gnark.
+type VerifyingKey struct {
+	alpha              curve.G1Affine
+	beta, delta        curve.G1Affine
+	betaG2, gamma      curve.G2Affine
+	deltaG2            curve.G2Affine
+	k                  []curve.G1Affine
+	publicCommitted    [][]uint64
+	commitmentKey      pedersen.VerifyingKey
+	e                  curve.GT
+	deltaNeg, gammaNeg curve.G2Affine
+}
+
+// Groth16 proof extended with the BSB22 commitment, as serialized by gnark.
+type Proof struct {
+	ar, krs       curve.G1Affine
+	bs            curve.G2Affine
+	commitments   []curve.G1Affine
+	commitmentPok curve.G1Affine
+}
+
+// ReadVerifyingKey decodes a verifying key, e.g. the vk.bin of the prover.
+func ReadVerifyingKey(r io.Reader) (*VerifyingKey, error) {
+	var vk VerifyingKey
+	dec := curve.NewDecoder(r)
+	for _, v := range []any{
+		&vk.alpha,
+		&vk.beta,
+		&vk.betaG2,
+		&vk.gamma,
+		&vk.delta,
+		&vk.deltaG2,
+		&vk.k,
+		&vk.publicCommitted,
+	} {
+		if err := dec.Decode(v); err != nil {
+			return nil, fmt.Errorf("Could not decode verifying key %s", err)
+		}
+	}
+	if _, err := vk.commitmentKey.ReadFrom(r); err != nil {
+		return nil, fmt.Errorf("Could not decode commitment key %s", err)
+	}
+	var err error
+	vk.e, err = curve.Pair([]curve.G1Affine{vk.alpha}, []curve.G2Affine{vk.betaG2})
+	if err != nil {
+		return nil, err
+	}
+	vk.deltaNeg.Neg(&vk.deltaG2)
+	vk.gammaNeg.Neg(&vk.gamma)
+	return &vk, nil
+}
+
+// ReadProof decodes a compressed proof, the compressed_content of the prover response.
+func ReadProof(r io.Reader) (*Proof, error) {
+	var proof Proof
+	dec := curve.NewDecoder(r)
+	for _, v := range []any{
+		&proof.ar,
+		&proof.bs,
+		&proof.krs,
+		&proof.commitments,
+		&proof.commitmentPok,
+	} {
+		if err := dec.Decode(v); err != nil {
+			return nil, fmt.Errorf("Could not decode proof %s", err)
+		}
+	}
+	return &proof, nil
+}
+
+// Size of the EVM encoded proof: the uncompressed A, B and C points followed by
+// the commitment and its proof of knowledge.
+const EVMProofSize = 2*curve.SizeOfG1AffineUncompressed + curve.SizeOfG2AffineUncompressed + 2*curve.SizeOfG1AffineUncompressed
+
+// ReadEVMProof decodes the evm_proof of the prover response, the encoding the
+// on-chain verifiers take.
+func ReadEVMProof(evmProof []byte) (*Proof, error) {
+	if len(evmProof) != EVMProofSize {
+		return nil, fmt.Errorf("Invalid EVM proof size, got %d, expected %d", len(evmProof), EVMProofSize)
+	}
+	var proof Proof
+	proof.commitments = make([]curve.G1Affine, 1)
+	offset := 0
+	for _, point := range []interface {
+		SetBytes([]byte) (int, error)
+	}{
+		&proof.ar,
+		&proof.bs,
+		&proof.krs,
+		&proof.commitments[0],
+		&proof.commitmentPok,
+	} {
+		n, err := point.SetBytes(evmProof[offset:])
+		if err != nil {
+			return nil, fmt.Errorf("Could not decode EVM proof %s", err)
+		}
+		offset += n
+	}
+	return &proof, nil
+}
+
+// The hash to field used by the prover to derive the commitment wires.
+func hashToField(data []byte) fr.Element {
+	return cometbn254.HashToField(data)
+}
+
+// Verify checks the proof against the public inputs of the circuit, excluding
+// the constant one wire.
+func Verify(vk *VerifyingKey, proof *Proof, publicInputs []fr.Element) error {
+	nbPublicVars := len(vk.k) - len(vk.publicCommitted)
+	if len(publicInputs) != nbPublicVars-1 {
+		return fmt.Errorf("invalid witness size, got %d, expected %d (public - ONE_WIRE)", len(publicInputs), nbPublicVars-1)
+	}
+	if len(proof.commitments) != len(vk.publicCommitted) {
+		return fmt.Errorf("invalid number of commitments, got %d, expected %d", len(proof.commitments), len(vk.publicCommitted))
+	}
+
+	if !proof.ar.IsInSubGroup() || !proof.krs.IsInSubGroup() || !proof.bs.IsInSubGroup() {
+		return ErrInvalidSubgroup
+	}
+
+	witness := make([]fr.Element, len(publicInputs), len(publicInputs)+len(vk.publicCommitted))
+	copy(witness, publicInputs)
+
+	commitmentsSerialized := make([]byte, 0, len(vk.publicCommitted)*fr.Bytes)
+	for i, committed := range vk.publicCommitted {
+		prehash := proof.commitments[i].Marshal()
+		for _, j := range committed {
+			if j == 0 || int(j) > len(publicInputs) {
+				return fmt.Errorf("invalid committed public input %d", j)
+			}
+			bz := publicInputs[j-1].Marshal()
+			prehash = append(prehash, bz...)
+		}
+		res := hashToField(prehash)
+		witness = append(witness, res)
+		bz := res.Marshal()
+		commitmentsSerialized = append(commitmentsSerialized, bz...)
+	}
+
+	folded, err := pedersen.FoldCommitments(proof.commitments, commitmentsSerialized)
+	if err != nil {
+		return err
+	}
+	if err := vk.commitmentKey.Verify(folded, proof.commitmentPok); err != nil {
+		return err
+	}
+
+	// e(Σx.[Kvk(t)]1, -[γ]2)
+	var kSum curve.G1Jac
+	if _, err := kSum.MultiExp(vk.k[1:], witness, ecc.MultiExpConfig{}); err != nil {
+		return err
+	}
+	kSum.AddMixed(&vk.k[0])
+	for i := range proof.commitments {
+		kSum.AddMixed(&proof.commitments[i])
+	}
+	var kSumAff curve.G1Affine
+	kSumAff.FromJacobian(&kSum)
+
+	right, err := curve.MillerLoop(
+		[]curve.G1Affine{kSumAff, proof.krs, proof.ar},
+		[]curve.G2Affine{vk.gammaNeg, vk.deltaNeg, proof.bs},
+	)
+	if err != nil {
+		return err
+	}
+	right = curve.FinalExponentiation(&right)
+	if !vk.e.Equal(&right) {
+		return ErrInvalidProof
+	}
+	return nil
+}
+
+// VerifyInputsHash checks a compressed light client proof against its sole
+// public input, the inputs hash.
+func VerifyInputsHash(vk *VerifyingKey, compressedProof []byte, inputsHash []byte) error {
+	proof, err := ReadProof(bytes.NewReader(compressedProof))
+	if err != nil {
+		return err
+	}
+	var input fr.Element
+	input.SetBytes(inputsHash)
+	return Verify(vk, proof, []fr.Element{input})
+}
+
+// VerifyEVMInputsHash checks an EVM encoded light client proof against its sole
+// public input, the inputs hash.
+func VerifyEVMInputsHash(vk *VerifyingKey, evmProof []byte, inputsHash []byte) error {
+	proof, err := ReadEVMProof(evmProof)
+	if err != nil {
+		return err
+	}
+	var input fr.Element
+	input.SetBytes(inputsHash)
+	return Verify(vk, proof, []fr.Element{input})
+}
@@ -0,0 +1,119 @@
+package verify
+
+import (
+	"bytes"
+	"testing"
+
+	cometbn254 "github.com/cometbft/cometbft/crypto/bn254"
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	backend_opts "github.com/consensys/gnark/backend"
+	"github.com/consensys/gnark/backend/groth16"
+	groth16_bn254 "github.com/consensys/gnark/backend/groth16/bn254"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/frontend/cs/r1cs"
+	"github.com/stretchr/testify/assert"
+)
+
+type committedCircuit struct {
+	Secret     frontend.Variable
+	InputsHash frontend.Variable `gnark:",public"`
+}
+
+func (c *committedCircuit) Define(api frontend.API) error {
+	commitment, err := api.(frontend.Committer).Commit(c.Secret, c.InputsHash)
+	if err != nil {
+		return err
+	}
+	api.AssertIsDifferent(commitment, 0)
+	api.AssertIsEqual(api.Mul(c.Secret, c.Secret), c.InputsHash)
+	return nil
+}
+
+type cometblsHashToField struct {
+	data []byte
+}
+
+func (c *cometblsHashToField) Write(p []byte) (n int, err error) {
+	c.data = append(c.data, p...)
+	return len(p), nil
+}
+
+func (c *cometblsHashToField) Sum(b []byte) []byte {
+	e := cometbn254.HashToField(c.data)
+	eB := e.Bytes()
+	return append(b, eB[:]...)
+}
+
+func (c *cometblsHashToField) Reset() {
+	c.data = []byte{}
+}
+
+func (c *cometblsHashToField) Size() int {
+	return fr.Bytes
+}
+
+func (c *cometblsHashToField) BlockSize() int {
+	return fr.Bytes
+}
+
+func TestVerifyInputsHash(t *testing.T) {
+	cs, err := frontend.Compile(ecc.BN254.ScalarField(), r1cs.NewBuilder, &committedCircuit{})
+	assert.NoError(t, err)
+	pk, vk, err := groth16.Setup(cs)
+	assert.NoError(t, err)
+
+	witness, err := frontend.NewWitness(&committedCircuit{Secret: 3, InputsHash: []byte{9}}, ecc.BN254.ScalarField())
+	assert.NoError(t, err)
+	proof, err := groth16.Prove(cs, pk, witness, backend_opts.WithProverHashToFieldFunction(&cometblsHashToField{}))
+	assert.NoError(t, err)
+
+	var vkBuffer, proofBuffer bytes.Buffer
+	_, err = vk.WriteTo(&vkBuffer)
+	assert.NoError(t, err)
+	_, err = proof.WriteTo(&proofBuffer)
+	assert.NoError(t, err)
+
+	decodedVK, err := ReadVerifyingKey(&vkBuffer)
+	assert.NoError(t, err)
+
+	assert.NoError(t, VerifyInputsHash(decodedVK, proofBuffer.Bytes(), []byte{9}))
+	assert.ErrorIs(t, VerifyInputsHash(decodedVK, proofBuffer.Bytes(), []byte{10}), ErrInvalidProof)
+
+	_, err = ReadProof(bytes.NewReader(proofBuffer.Bytes()[:10]))
+	assert.Error(t, err)
+}
+
+func TestVerifyEVMInputsHash(t *testing.T) {
+	cs, err := frontend.Compile(ecc.BN254.ScalarField(), r1cs.NewBuilder, &committedCircuit{})
+	assert.NoError(t, err)
+	pk, vk, err := groth16.Setup(cs)
+	assert.NoError(t, err)
+
+	witness, err := frontend.NewWitness(&committedCircuit{Secret: 3, InputsHash: []byte{9}}, ecc.BN254.ScalarField())
+	assert.NoError(t, err)
+	proof, err := groth16.Prove(cs, pk, witness, backend_opts.WithProverHashToFieldFunction(&cometblsHashToField{}))
+	assert.NoError(t, err)
+
+	var vkBuffer, proofBuffer bytes.Buffer
+	_, err = vk.WriteTo(&vkBuffer)
+	assert.NoError(t, err)
+	_, err = proof.WriteRawTo(&proofBuffer)
+	assert.NoError(t, err)
+	decodedVK, err := ReadVerifyingKey(&vkBuffer)
+	assert.NoError(t, err)
+
+	// Encoded the way the prover does
+	bn254Proof := proof.(*groth16_bn254.Proof)
+	evmProof := append(append(proofBuffer.Bytes()[:256], bn254Proof.Commitments[0].Marshal()...), bn254Proof.CommitmentPok.Marshal()...)
+	assert.Len(t, evmProof, EVMProofSize)
+
+	assert.NoError(t, VerifyEVMInputsHash(decodedVK, evmProof, []byte{9}))
+	assert.ErrorIs(t, VerifyEVMInputsHash(decodedVK, evmProof, []byte{10}), ErrInvalidProof)
+
+	tampered := bytes.Clone(evmProof)
+	tampered[200] ^= 1
+	assert.Error(t, VerifyEVMInputsHash(decodedVK, tampered, []byte{9}))
+
+	assert.Error(t, VerifyEVMInputsHash(decodedVK, evmProof[:EVMProofSize-1], []byte{9}))
+}